@@ -63,7 +63,7 @@ func (opts buildOptions) toAPIBuildOptions(services []string) (api.BuildOptions,
 	return api.BuildOptions{
 		Pull:     opts.pull,
 		Push:     opts.push,
-		Progress: ui.Mode,
+		Progress: buildxProgressMode(ui.Mode),
 		Args:     types.NewMappingWithEquals(opts.args),
 		NoCache:  opts.noCache,
 		Quiet:    opts.quiet,
@@ -73,6 +73,20 @@ func (opts buildOptions) toAPIBuildOptions(services []string) (api.BuildOptions,
 	}, nil
 }
 
+// buildxProgressMode maps the compose progress mode to one buildx supports
+func buildxProgressMode(mode string) string {
+	switch mode {
+	case ui.ModeAuto, ui.ModeTTY, ui.ModePlain, ui.ModeQuiet:
+		return mode
+	case ui.ModeJSON:
+		// keep buildx text output out of the JSON event stream
+		return buildx.PrinterModeQuiet
+	default:
+		// custom registered writer
+		return buildx.PrinterModePlain
+	}
+}
+
 func buildCommand(p *ProjectOptions, progress *string, backend api.Service) *cobra.Command {
 	opts := buildOptions{
 		ProjectOptions: p,
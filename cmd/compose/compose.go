@@ -19,11 +19,13 @@ package compose
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/compose-spec/compose-go/dotenv"
@@ -43,6 +45,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/docker/compose/v2/cmd/formatter"
+	"github.com/docker/compose/v2/internal/tracing"
 	"github.com/docker/compose/v2/pkg/api"
 	"github.com/docker/compose/v2/pkg/compose"
 	ui "github.com/docker/compose/v2/pkg/progress"
@@ -60,6 +63,14 @@ const (
 	ComposeRemoveOrphans = "COMPOSE_REMOVE_ORPHANS"
 	// ComposeIgnoreOrphans ignore "orphaned" containers
 	ComposeIgnoreOrphans = "COMPOSE_IGNORE_ORPHANS"
+	// ComposeProgressSpinner select the spinner style used by progress display
+	ComposeProgressSpinner = "COMPOSE_PROGRESS_SPINNER"
+	// ComposeProgressInterval set the refresh interval of progress display
+	ComposeProgressInterval = "COMPOSE_PROGRESS_INTERVAL"
+	// ComposeProgressASCII restrict progress display to ASCII characters
+	ComposeProgressASCII = "COMPOSE_PROGRESS_ASCII"
+	// ComposeProgressMilliseconds render elapsed time under a second in milliseconds
+	ComposeProgressMilliseconds = "COMPOSE_PROGRESS_MILLISECONDS"
 )
 
 // Command defines a compose CLI command as a func with args
@@ -269,13 +280,16 @@ func RootCommand(streams command.Cli, backend api.Service) *cobra.Command { //no
 
 	opts := ProjectOptions{}
 	var (
-		ansi     string
-		noAnsi   bool
-		verbose  bool
-		version  bool
-		parallel int
-		dryRun   bool
-		progress string
+		ansi        string
+		noAnsi      bool
+		verbose     bool
+		version     bool
+		parallel    int
+		dryRun      bool
+		progress    string
+		quiet       bool
+		noColor     bool
+		progressLog string
 	)
 	c := &cobra.Command{
 		Short:            "Docker Compose",
@@ -328,7 +342,10 @@ func RootCommand(streams command.Cli, backend api.Service) *cobra.Command { //no
 
 			formatter.SetANSIMode(streams, ansi)
 
-			if noColor, ok := os.LookupEnv("NO_COLOR"); ok && noColor != "" {
+			if v, ok := os.LookupEnv("NO_COLOR"); ok && v != "" {
+				noColor = true
+			}
+			if noColor {
 				ui.NoColor()
 				formatter.SetANSIMode(streams, formatter.Never)
 			}
@@ -348,6 +365,12 @@ func RootCommand(streams command.Cli, backend api.Service) *cobra.Command { //no
 					return fmt.Errorf("can't use --progress tty while ANSI support is disabled")
 				}
 				ui.Mode = ui.ModeTTY
+			case ui.ModeTTYASCII:
+				if ansi == "never" {
+					return fmt.Errorf("can't use --progress tty-ascii while ANSI support is disabled")
+				}
+				ui.Mode = ui.ModeTTY
+				ui.ASCII()
 			case ui.ModePlain:
 				if ansi == "always" {
 					return fmt.Errorf("can't use --progress plain while ANSI support is forced")
@@ -355,9 +378,54 @@ func RootCommand(streams command.Cli, backend api.Service) *cobra.Command { //no
 				ui.Mode = ui.ModePlain
 			case ui.ModeQuiet, "none":
 				ui.Mode = ui.ModeQuiet
+			case ui.ModeJSON:
+				ui.Mode = ui.ModeJSON
 			default:
-				return fmt.Errorf("unsupported --progress value %q", progress)
+				if !utils.StringContains(ui.RegisteredWriters(), progress) {
+					return fmt.Errorf("unsupported --progress value %q", progress)
+				}
+				ui.Mode = progress
+			}
+
+			if quiet {
+				if ui.Mode != ui.ModeAuto && ui.Mode != ui.ModeQuiet {
+					return errors.New(`cannot specify both "--quiet" and "--progress"`)
+				}
+				ui.Mode = ui.ModeQuiet
+			}
+
+			var writerOptions []ui.WriterOption
+			if progressLog != "" {
+				eventLog := &lazyFile{path: progressLog}
+				writerOptions = append(writerOptions, ui.WithEventLog(eventLog))
+				closeAfterRun(cmd, eventLog)
+			}
+
+			if utils.StringToBool(os.Getenv(ComposeProgressASCII)) {
+				ui.ASCII()
+			}
+			if v, ok := os.LookupEnv(ComposeProgressSpinner); ok {
+				style, err := ui.ParseSpinnerStyle(v)
+				if err != nil {
+					return fmt.Errorf("%s: %w", ComposeProgressSpinner, err)
+				}
+				writerOptions = append(writerOptions, ui.WithSpinner(style))
+			}
+			if v, ok := os.LookupEnv(ComposeProgressInterval); ok {
+				interval, err := ui.ParseRefreshInterval(v)
+				if err != nil {
+					return fmt.Errorf("%s: %w", ComposeProgressInterval, err)
+				}
+				writerOptions = append(writerOptions, ui.WithRefreshInterval(interval))
+			}
+			if utils.StringToBool(os.Getenv(ComposeProgressMilliseconds)) {
+				writerOptions = append(writerOptions, ui.WithMilliseconds())
 			}
+			// tracing is configured by cmdtrace before this hook runs
+			if tracing.Enabled() {
+				writerOptions = append(writerOptions, ui.WithTracing(tracing.Tracer))
+			}
+			ui.DefaultWriterOptions = writerOptions
 
 			if opts.WorkDir != "" {
 				if opts.ProjectDir != "" {
@@ -461,7 +529,10 @@ func RootCommand(streams command.Cli, backend api.Service) *cobra.Command { //no
 
 	c.Flags().StringVar(&progress, "progress", buildx.PrinterModeAuto, fmt.Sprintf(`Set type of progress output (%s)`, strings.Join(printerModes, ", ")))
 
+	c.Flags().StringVar(&progressLog, "progress-log", "", "Append progress events as JSON lines to a file")
 	c.Flags().StringVar(&ansi, "ansi", "auto", `Control when to print ANSI control characters ("never"|"always"|"auto")`)
+	c.Flags().BoolVar(&noColor, "no-color", false, "Produce monochrome output.")
+	c.Flags().BoolVar(&quiet, "quiet", false, "Only display errors, don't print progress output")
 	c.Flags().IntVar(&parallel, "parallel", -1, `Control max parallelism, -1 for unlimited`)
 	c.Flags().BoolVarP(&version, "version", "v", false, "Show the Docker Compose version information")
 	c.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Execute command in dry run mode")
@@ -497,9 +568,71 @@ func setEnvWithDotEnv(prjOpts *ProjectOptions) error {
 	return nil
 }
 
+// lazyFile opens the file to append to on first write, so that commands which don't report
+// progress don't create it
+type lazyFile struct {
+	path string
+	mtx  sync.Mutex
+	file *os.File
+	err  error
+}
+
+func (l *lazyFile) Write(p []byte) (int, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.file == nil && l.err == nil {
+		l.file, l.err = os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if l.err != nil {
+			logrus.Warnf("failed to open progress log: %v", l.err)
+		}
+	}
+	if l.err != nil {
+		return 0, l.err
+	}
+	return l.file.Write(p)
+}
+
+func (l *lazyFile) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// closeAfterRun wraps the command's RunE so that closer is closed once the command completes.
+// PersistentPostRunE can't be used as it only runs if RunE does not return an error, while
+// the progress log is most useful to investigate a failed command.
+func closeAfterRun(cmd *cobra.Command, closer io.Closer) {
+	runE := cmd.RunE
+	if runE == nil {
+		run := cmd.Run
+		if run == nil {
+			return
+		}
+		runE = func(cmd *cobra.Command, args []string) error {
+			run(cmd, args)
+			return nil
+		}
+		cmd.Run = nil
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := runE(cmd, args)
+		if closeErr := closer.Close(); closeErr != nil {
+			logrus.Warnf("failed to close progress log: %v", closeErr)
+		}
+		return err
+	}
+}
+
 var printerModes = []string{
 	ui.ModeAuto,
 	ui.ModeTTY,
+	ui.ModeTTYASCII,
 	ui.ModePlain,
 	ui.ModeQuiet,
+	ui.ModeJSON,
 }
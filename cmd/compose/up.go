@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/docker/compose/v2/pkg/api"
+	ui "github.com/docker/compose/v2/pkg/progress"
 	"github.com/docker/compose/v2/pkg/utils"
 )
 
@@ -49,6 +50,7 @@ type upOptions struct {
 	timestamp          bool
 	wait               bool
 	waitTimeout        int
+	timings            bool
 }
 
 func (opts upOptions) apply(project *types.Project, services []string) error {
@@ -114,6 +116,7 @@ func upCommand(p *ProjectOptions, streams api.Streams, backend api.Service) *cob
 	flags.StringArrayVar(&up.noAttach, "no-attach", []string{}, "Don't attach to specified service.")
 	flags.BoolVar(&up.wait, "wait", false, "Wait for services to be running|healthy. Implies detached mode.")
 	flags.IntVar(&up.waitTimeout, "wait-timeout", 0, "timeout waiting for application to be running|healthy.")
+	flags.BoolVar(&up.timings, "timings", false, "Print time spent on each resource once done.")
 
 	return upCmd
 }
@@ -200,6 +203,16 @@ func runUp(ctx context.Context, streams api.Streams, backend api.Service, create
 		QuietPull:            createOptions.quietPull,
 	}
 
+	if upOptions.timings {
+		var summary func() ui.Summary
+		ctx, summary = ui.WithSummary(ctx)
+		defer func() {
+			if ui.Mode != ui.ModeJSON {
+				summary().Print(streams.Err())
+			}
+		}()
+	}
+
 	if upOptions.noStart {
 		return backend.Create(ctx, project, create)
 	}
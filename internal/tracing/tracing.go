@@ -23,6 +23,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/docker/compose/v2/internal"
 	"go.opentelemetry.io/otel/attribute"
@@ -48,6 +49,13 @@ func init() {
 
 var Tracer = otel.Tracer("compose")
 
+var enabled atomic.Bool
+
+// Enabled reports whether InitTracing configured an exporter for spans
+func Enabled() bool {
+	return enabled.Load()
+}
+
 // OTLPConfig contains the necessary values to initialize an OTLP client
 // manually.
 //
@@ -122,6 +130,7 @@ func InitProvider(dockerCli command.Cli) (ShutdownFunc, error) {
 		sdktrace.WithSpanProcessor(sp),
 	)
 	otel.SetTracerProvider(tracerProvider)
+	enabled.Store(len(exporters) > 0)
 
 	// Shutdown will flush any remaining spans and shut down the exporter.
 	return tracerProvider.Shutdown, nil
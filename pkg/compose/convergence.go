@@ -19,6 +19,7 @@ package compose
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,6 +34,7 @@ import (
 	"github.com/docker/compose/v2/internal/tracing"
 	moby "github.com/docker/docker/api/types"
 	containerType "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -214,7 +216,7 @@ func (c *convergence) ensureService(ctx context.Context, project *types.Project,
 		}
 
 		// Enforce non-diverged containers are running
-		w := progress.ContextWriter(ctx)
+		w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 		name := getContainerProgressName(container)
 		switch container.State {
 		case ContainerRunning:
@@ -308,7 +310,7 @@ const ServiceConditionRunningOrHealthy = "running_or_healthy"
 //nolint:gocyclo
 func (s *composeService) waitDependencies(ctx context.Context, project *types.Project, dependencies types.DependsOnConfig, containers Containers) error {
 	eg, _ := errgroup.WithContext(ctx)
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	for dep, config := range dependencies {
 		if shouldWait, err := shouldWaitForDependency(dep, config, project); err != nil {
 			return err
@@ -321,6 +323,8 @@ func (s *composeService) waitDependencies(ctx context.Context, project *types.Pr
 
 		dep, config := dep, config
 		eg.Go(func() error {
+			stopLogs := s.followStartupLogs(ctx, w, waitingFor)
+			defer stopLogs()
 			ticker := time.NewTicker(500 * time.Millisecond)
 			defer ticker.Stop()
 			for {
@@ -392,6 +396,61 @@ func (s *composeService) waitDependencies(ctx context.Context, project *types.Pr
 	return eg.Wait()
 }
 
+// followStartupLogs streams output of the containers being waited for to the progress writer, when
+// it can render log lines, until the returned function is called
+func (s *composeService) followStartupLogs(ctx context.Context, w progress.Writer, containers Containers) func() {
+	logger, ok := w.(progress.Logger)
+	if !ok || len(containers) == 0 {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	since := strconv.FormatInt(time.Now().Unix(), 10)
+	var wg sync.WaitGroup
+	for _, container := range containers {
+		container := container
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.followContainerLogs(ctx, container, since, func(line string) {
+				logger.Log(getContainerProgressName(container), line)
+			})
+			if err != nil && ctx.Err() == nil {
+				logrus.Debugf("failed to follow logs of %s: %v", getCanonicalContainerName(container), err)
+			}
+		}()
+	}
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+func (s *composeService) followContainerLogs(ctx context.Context, container moby.Container, since string, consumer func(string)) error {
+	cnt, err := s.apiClient().ContainerInspect(ctx, container.ID)
+	if err != nil {
+		return err
+	}
+	r, err := s.apiClient().ContainerLogs(ctx, container.ID, moby.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Since:      since,
+	})
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:errcheck
+
+	w := utils.GetWriter(consumer)
+	defer w.Close() //nolint:errcheck
+	if cnt.Config.Tty {
+		_, err = io.Copy(w, r)
+	} else {
+		_, err = stdcopy.StdCopy(w, w, r)
+	}
+	return err
+}
+
 func shouldWaitForDependency(serviceName string, dependencyConfig types.ServiceDependency, project *types.Project) (bool, error) {
 	if dependencyConfig.Condition == types.ServiceConditionStarted {
 		// already managed by InDependencyOrder
@@ -447,11 +506,12 @@ func getScale(config types.ServiceConfig) (int, error) {
 
 func (s *composeService) createContainer(ctx context.Context, project *types.Project, service types.ServiceConfig,
 	name string, number int, opts createOptions) (container moby.Container, err error) {
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	eventName := "Container " + name
 	w.Event(progress.CreatingEvent(eventName))
 	container, err = s.createMobyContainer(ctx, project, service, name, number, nil, opts, w)
 	if err != nil {
+		w.Event(progress.ErrorDetailsEvent(eventName, err))
 		return
 	}
 	w.Event(progress.CreatedEvent(eventName))
@@ -461,7 +521,7 @@ func (s *composeService) createContainer(ctx context.Context, project *types.Pro
 func (s *composeService) recreateContainer(ctx context.Context, project *types.Project, service types.ServiceConfig,
 	replaced moby.Container, inherit bool, timeout *time.Duration) (moby.Container, error) {
 	var created moby.Container
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	w.Event(progress.NewEvent(getContainerProgressName(replaced), progress.Working, "Recreate"))
 
 	number, err := strconv.Atoi(replaced.Labels[api.ContainerNumberLabel])
@@ -524,10 +584,11 @@ func setDependentLifecycle(project *types.Project, service string, strategy stri
 }
 
 func (s *composeService) startContainer(ctx context.Context, container moby.Container) error {
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	w.Event(progress.NewEvent(getContainerProgressName(container), progress.Working, "Restart"))
 	err := s.apiClient().ContainerStart(ctx, container.ID, moby.ContainerStartOptions{})
 	if err != nil {
+		w.Event(progress.ErrorDetailsEvent(getContainerProgressName(container), err))
 		return err
 	}
 	w.Event(progress.NewEvent(getContainerProgressName(container), progress.Done, "Restarted"))
@@ -729,7 +790,7 @@ func (s *composeService) startService(ctx context.Context, project *types.Projec
 		return fmt.Errorf("service %q has no container to start", service.Name)
 	}
 
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	for _, container := range containers.filter(isService(service.Name)) {
 		if container.State == ContainerRunning {
 			continue
@@ -738,6 +799,7 @@ func (s *composeService) startService(ctx context.Context, project *types.Projec
 		w.Event(progress.StartingEvent(eventName))
 		err := s.apiClient().ContainerStart(ctx, container.ID, moby.ContainerStartOptions{})
 		if err != nil {
+			w.Event(progress.ErrorDetailsEvent(eventName, err))
 			return err
 		}
 		w.Event(progress.StartedEvent(eventName))
@@ -107,7 +107,7 @@ func (s *composeService) create(ctx context.Context, project *types.Project, opt
 	orphans := observedState.filter(isNotService(allServiceNames...))
 	if len(orphans) > 0 && !options.IgnoreOrphans {
 		if options.RemoveOrphans {
-			w := progress.ContextWriter(ctx)
+			w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 			err := s.removeContainers(ctx, w, orphans, nil, false)
 			if err != nil {
 				return err
@@ -1176,12 +1176,12 @@ func (s *composeService) resolveOrCreateNetwork(ctx context.Context, n *types.Ne
 		createOpts.IPAM.Config = append(createOpts.IPAM.Config, config)
 	}
 	networkEventName := fmt.Sprintf("Network %s", n.Name)
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Networks")
 	w.Event(progress.CreatingEvent(networkEventName))
 
 	_, err = s.apiClient().NetworkCreate(ctx, n.Name, createOpts)
 	if err != nil {
-		w.Event(progress.ErrorEvent(networkEventName))
+		w.Event(progress.ErrorDetailsEvent(networkEventName, err))
 		return errors.Wrapf(err, "failed to create network %s", n.Name)
 	}
 	w.Event(progress.CreatedEvent(networkEventName))
@@ -1260,7 +1260,7 @@ func (s *composeService) ensureVolume(ctx context.Context, volume types.VolumeCo
 
 func (s *composeService) createVolume(ctx context.Context, volume types.VolumeConfig) error {
 	eventName := fmt.Sprintf("Volume %q", volume.Name)
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Volumes")
 	w.Event(progress.CreatingEvent(eventName))
 	_, err := s.apiClient().VolumeCreate(ctx, volume_api.CreateOptions{
 		Labels:     volume.Labels,
@@ -1269,7 +1269,7 @@ func (s *composeService) createVolume(ctx context.Context, volume types.VolumeCo
 		DriverOpts: volume.DriverOpts,
 	})
 	if err != nil {
-		w.Event(progress.ErrorEvent(eventName))
+		w.Event(progress.ErrorDetailsEvent(eventName, err))
 		return err
 	}
 	w.Event(progress.CreatedEvent(eventName))
@@ -77,7 +77,7 @@ func (s *composeService) down(ctx context.Context, projectName string, options a
 
 	err = InReverseDependencyOrder(ctx, project, func(c context.Context, service string) error {
 		serviceContainers := containers.filter(isService(service))
-		err := s.removeContainers(ctx, w, serviceContainers, options.Timeout, options.Volumes)
+		err := s.removeContainers(ctx, progress.GroupWriter(w, "Containers"), serviceContainers, options.Timeout, options.Volumes)
 		return err
 	}, WithRootNodesAndDown(options.Services))
 	if err != nil {
@@ -86,16 +86,16 @@ func (s *composeService) down(ctx context.Context, projectName string, options a
 
 	orphans := containers.filter(isNotService(project.ServiceNames()...))
 	if options.RemoveOrphans && len(orphans) > 0 {
-		err := s.removeContainers(ctx, w, orphans, options.Timeout, false)
+		err := s.removeContainers(ctx, progress.GroupWriter(w, "Containers"), orphans, options.Timeout, false)
 		if err != nil {
 			return err
 		}
 	}
 
-	ops := s.ensureNetworksDown(ctx, project, w)
+	ops := s.ensureNetworksDown(ctx, project, progress.GroupWriter(w, "Networks"))
 
 	if options.Images != "" {
-		imgOps, err := s.ensureImagesDown(ctx, project, options, w)
+		imgOps, err := s.ensureImagesDown(ctx, project, options, progress.GroupWriter(w, "Images"))
 		if err != nil {
 			return err
 		}
@@ -103,7 +103,7 @@ func (s *composeService) down(ctx context.Context, projectName string, options a
 	}
 
 	if options.Volumes {
-		ops = append(ops, s.ensureVolumesDown(ctx, project, w)...)
+		ops = append(ops, s.ensureVolumesDown(ctx, project, progress.GroupWriter(w, "Volumes"))...)
 	}
 
 	if !resourceToRemove && len(ops) == 0 {
@@ -225,7 +225,7 @@ func (s *composeService) removeNetwork(ctx context.Context, composeNetworkName s
 			if errdefs.IsNotFound(err) {
 				continue
 			}
-			w.Event(progress.ErrorEvent(eventName))
+			w.Event(progress.ErrorDetailsEvent(eventName, err))
 			return errors.Wrapf(err, fmt.Sprintf("failed to remove network %s", name))
 		}
 		w.Event(progress.RemovedEvent(eventName))
@@ -286,7 +286,7 @@ func (s *composeService) stopContainer(ctx context.Context, w progress.Writer, c
 	timeoutInSecond := utils.DurationSecondToInt(timeout)
 	err := s.apiClient().ContainerStop(ctx, container.ID, containerType.StopOptions{Timeout: timeoutInSecond})
 	if err != nil {
-		w.Event(progress.ErrorMessageEvent(eventName, "Error while Stopping"))
+		w.Event(progress.ErrorDetailsEvent(eventName, err))
 		return err
 	}
 	w.Event(progress.StoppedEvent(eventName))
@@ -320,7 +320,7 @@ func (s *composeService) removeContainers(ctx context.Context, w progress.Writer
 				RemoveVolumes: volumes,
 			})
 			if err != nil && !errdefs.IsNotFound(err) && !errdefs.IsConflict(err) {
-				w.Event(progress.ErrorMessageEvent(eventName, "Error while Removing"))
+				w.Event(progress.ErrorDetailsEvent(eventName, err))
 				return err
 			}
 			w.Event(progress.RemovedEvent(eventName))
@@ -69,7 +69,7 @@ func (s *composeService) kill(ctx context.Context, projectName string, options a
 				w.Event(progress.KillingEvent(eventName))
 				err := s.apiClient().ContainerKill(ctx, container.ID, options.Signal)
 				if err != nil {
-					w.Event(progress.ErrorMessageEvent(eventName, "Error while Killing"))
+					w.Event(progress.ErrorDetailsEvent(eventName, err))
 					return err
 				}
 				w.Event(progress.KilledEvent(eventName))
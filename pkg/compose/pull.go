@@ -53,7 +53,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 		return err
 	}
 
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Images")
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.SetLimit(s.maxConcurrency)
 
@@ -68,7 +68,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 		if service.Image == "" {
 			w.Event(progress.Event{
 				ID:     service.Name,
-				Status: progress.Done,
+				Status: progress.Skipped,
 				Text:   "Skipped - No image to be pulled",
 			})
 			continue
@@ -78,7 +78,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 		case types.PullPolicyNever, types.PullPolicyBuild:
 			w.Event(progress.Event{
 				ID:     service.Name,
-				Status: progress.Done,
+				Status: progress.Skipped,
 				Text:   "Skipped",
 			})
 			continue
@@ -86,7 +86,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 			if imageAlreadyPresent(service.Image, images) {
 				w.Event(progress.Event{
 					ID:     service.Name,
-					Status: progress.Done,
+					Status: progress.Skipped,
 					Text:   "Skipped - Image is already present locally",
 				})
 				continue
@@ -96,7 +96,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 		if service.Build != nil && opts.IgnoreBuildable {
 			w.Event(progress.Event{
 				ID:     service.Name,
-				Status: progress.Done,
+				Status: progress.Skipped,
 				Text:   "Skipped - Image can be built",
 			})
 			continue
@@ -105,7 +105,7 @@ func (s *composeService) pull(ctx context.Context, project *types.Project, opts
 		if s, ok := imagesBeingPulled[service.Image]; ok {
 			w.Event(progress.Event{
 				ID:     service.Name,
-				Status: progress.Done,
+				Status: progress.Skipped,
 				Text:   fmt.Sprintf("Skipped - Image is already being pulled by %v", s),
 			})
 			continue
@@ -282,7 +282,7 @@ func (s *composeService) pullRequiredImages(ctx context.Context, project *types.
 	}
 
 	return progress.Run(ctx, func(ctx context.Context) error {
-		w := progress.ContextWriter(ctx)
+		w := progress.GroupWriter(progress.ContextWriter(ctx), "Images")
 		eg, ctx := errgroup.WithContext(ctx)
 		eg.SetLimit(s.maxConcurrency)
 		pulledImages := make([]string, len(needPull))
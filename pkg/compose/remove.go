@@ -98,7 +98,7 @@ func (s *composeService) Remove(ctx context.Context, projectName string, options
 }
 
 func (s *composeService) remove(ctx context.Context, containers Containers, options api.RemoveOptions) error {
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	eg, ctx := errgroup.WithContext(ctx)
 	for _, container := range containers {
 		container := container
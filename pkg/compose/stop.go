@@ -49,7 +49,7 @@ func (s *composeService) stop(ctx context.Context, projectName string, options a
 		options.Services = project.ServiceNames()
 	}
 
-	w := progress.ContextWriter(ctx)
+	w := progress.GroupWriter(progress.ContextWriter(ctx), "Containers")
 	return InReverseDependencyOrder(ctx, project, func(c context.Context, service string) error {
 		if !utils.StringContains(options.Services, service) {
 			return nil
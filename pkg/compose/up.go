@@ -62,7 +62,8 @@ func (s *composeService) Up(ctx context.Context, project *types.Project, options
 
 	stopFunc := func() error {
 		fmt.Fprintln(s.stdinfo(), "Aborting on container exit...")
-		ctx := context.Background()
+		// keep collecting timings, but don't cancel stop with the up command
+		ctx := context.WithoutCancel(ctx)
 		return progress.Run(ctx, func(ctx context.Context) error {
 			go func() {
 				<-signalChan
@@ -0,0 +1,83 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import "sync"
+
+// coalescer buffers progress updates of working events, so that only the latest update received
+// within a refresh interval is applied. Status transitions are never coalesced. The zero value
+// is ready to use.
+type coalescer struct {
+	mtx     sync.Mutex
+	working map[string]bool
+	pending map[string]Event
+	order   []string
+}
+
+// offer buffers e if it only updates an event already known to be working, and reports
+// whether it has been buffered. Otherwise, a previously buffered update for the same event
+// is discarded as e supersedes it.
+func (c *coalescer) offer(e Event) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if e.Status != Working || !c.working[e.ID] {
+		delete(c.pending, e.ID)
+		return false
+	}
+	if c.pending == nil {
+		c.pending = map[string]Event{}
+	}
+	if _, ok := c.pending[e.ID]; !ok {
+		c.order = append(c.order, e.ID)
+	}
+	c.pending[e.ID] = e
+	return true
+}
+
+// track records whether an event is working, so that its next updates can be coalesced
+func (c *coalescer) track(id string, working bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.working == nil {
+		c.working = map[string]bool{}
+	}
+	c.working[id] = working
+}
+
+// reset forgets about tracked events and discards buffered updates
+func (c *coalescer) reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.working = nil
+	c.pending = nil
+	c.order = nil
+}
+
+// drain returns buffered updates in order of arrival
+func (c *coalescer) drain() []Event {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var events []Event
+	for _, id := range c.order {
+		if e, ok := c.pending[id]; ok {
+			events = append(events, e)
+		}
+	}
+	c.pending = nil
+	c.order = nil
+	return events
+}
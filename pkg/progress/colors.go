@@ -34,8 +34,10 @@ var (
 	SuccessColor colorFunc = aec.GreenF.Apply
 	ErrorColor   colorFunc = aec.RedF.With(aec.Bold).Apply
 	PrefixColor  colorFunc = aec.CyanF.Apply
+	SkippedColor colorFunc = aec.Faint.Apply
 )
 
+// NoColor disables colors in progress output
 func NoColor() {
 	DoneColor = nocolor
 	TimerColor = nocolor
@@ -44,4 +46,5 @@ func NoColor() {
 	SuccessColor = nocolor
 	ErrorColor = nocolor
 	PrefixColor = nocolor
+	SkippedColor = nocolor
 }
@@ -17,6 +17,7 @@
 package progress
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -27,15 +28,36 @@ func (s EventStatus) colorFn() colorFunc {
 	switch s {
 	case Done:
 		return SuccessColor
-	case Warning:
+	case Warning, Canceled:
 		return WarningColor
 	case Error:
 		return ErrorColor
+	case Skipped:
+		return SkippedColor
 	default:
 		return nocolor
 	}
 }
 
+func (s EventStatus) String() string {
+	switch s {
+	case Working:
+		return "Working"
+	case Done:
+		return "Done"
+	case Warning:
+		return "Warning"
+	case Error:
+		return "Error"
+	case Canceled:
+		return "Canceled"
+	case Skipped:
+		return "Skipped"
+	default:
+		return fmt.Sprintf("EventStatus(%d)", int(s))
+	}
+}
+
 const (
 	// Working means that the current task is working
 	Working EventStatus = iota
@@ -45,15 +67,21 @@ const (
 	Warning
 	// Error means that the current task has errored
 	Error
+	// Canceled means that the current task was interrupted before completion
+	Canceled
+	// Skipped means that the current task required no change
+	Skipped
 )
 
 // Event represents a progress event.
 type Event struct {
 	ID         string
 	ParentID   string
+	Group      string
 	Text       string
 	Status     EventStatus
 	StatusText string
+	Details    string // full error message, rendered once progress completed
 	Current    int64
 	Percent    int
 
@@ -73,6 +101,23 @@ func ErrorEvent(id string) Event {
 	return NewEvent(id, Error, "Error")
 }
 
+// ErrorDetailsEvent creates a new Error Event with the full error message attached
+func ErrorDetailsEvent(id string, err error) Event {
+	e := ErrorEvent(id)
+	e.Details = err.Error()
+	return e
+}
+
+// WarningEvent creates a new Warning Event with message, for non-fatal conditions
+func WarningEvent(id string, msg string) Event {
+	return NewEvent(id, Warning, msg)
+}
+
+// CanceledEvent creates a new Canceled Event
+func CanceledEvent(id string) Event {
+	return NewEvent(id, Canceled, "Canceled")
+}
+
 // CreatingEvent creates a new Create in progress Event
 func CreatingEvent(id string) Event {
 	return NewEvent(id, Working, "Creating")
@@ -113,9 +158,9 @@ func RestartedEvent(id string) Event {
 	return NewEvent(id, Done, "Restarted")
 }
 
-// RunningEvent creates a new Running in progress Event
+// RunningEvent creates a new Skipped Event for a container which is already running
 func RunningEvent(id string) Event {
-	return NewEvent(id, Done, "Running")
+	return NewEvent(id, Skipped, "Running")
 }
 
 // CreatedEvent creates a new Created (done) Event
@@ -153,7 +198,7 @@ func RemovedEvent(id string) Event {
 	return NewEvent(id, Done, "Removed")
 }
 
-// SkippedEvent creates a new Skipped Event
+// SkippedEvent creates a new Warning Event for a task which has been skipped for reason
 func SkippedEvent(id string, reason string) Event {
 	return Event{
 		ID:         id,
@@ -186,10 +231,12 @@ func (e *Event) Spinner() any {
 	switch e.Status {
 	case Done:
 		return SuccessColor(spinnerDone)
-	case Warning:
+	case Warning, Canceled:
 		return WarningColor(spinnerWarning)
 	case Error:
 		return ErrorColor(spinnerError)
+	case Skipped:
+		return SkippedColor(spinnerDone)
 	default:
 		return e.spinner.String()
 	}
@@ -0,0 +1,186 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/compose/v2/pkg/api"
+)
+
+// isGitHubActions detects execution by a GitHub Actions runner
+func isGitHubActions() bool {
+	return os.Getenv("GITHUB_ACTIONS") == "true"
+}
+
+// githubWriter renders events as plain text, folding lines related to a top-level resource into a
+// GitHub Actions log group once it completes. As resources are processed concurrently, lines are
+// buffered until then so that groups never interleave. Failures are reported as error annotations.
+type githubWriter struct {
+	out     io.Writer
+	done    chan bool
+	dryRun  bool
+	mtx     *sync.Mutex
+	last    map[string]Event
+	groups  map[string][]string
+	pending []string
+	timings *timings
+}
+
+func newGitHubWriter(out io.Writer, dryRun bool) *githubWriter {
+	return &githubWriter{
+		out:     out,
+		done:    make(chan bool, 1),
+		dryRun:  dryRun,
+		mtx:     &sync.Mutex{},
+		last:    map[string]Event{},
+		groups:  map[string][]string{},
+		timings: newTimings(),
+	}
+}
+
+func (p *githubWriter) Start(ctx context.Context) error {
+	defer p.flushAll()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.done:
+		return nil
+	}
+}
+
+func (p *githubWriter) Event(e Event) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.timings.record(e, time.Now())
+	root := e.ID
+	if e.ParentID != "" {
+		root = e.ParentID
+	}
+	if last, ok := p.last[e.ID]; !ok || isTransition(last, e) {
+		p.last[e.ID] = e
+		p.buffer(root, joinNonEmpty(p.prefix(), e.ID, e.Text, e.StatusText))
+	}
+	if e.ParentID == "" && e.Status != Working {
+		p.flush(root, e)
+	}
+}
+
+func (p *githubWriter) Events(events []Event) {
+	for _, e := range events {
+		p.Event(e)
+	}
+}
+
+func (p *githubWriter) TailMsgf(msg string, args ...interface{}) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	fmt.Fprintln(p.out, p.prefix()+fmt.Sprintf(msg, args...))
+}
+
+func (p *githubWriter) Log(id string, line string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.buffer(id, fmt.Sprintf("%s | %s", id, line))
+}
+
+func (p *githubWriter) Summary() Summary {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.timings.summary()
+}
+
+func (p *githubWriter) Stop() {
+	p.done <- true
+}
+
+func (p *githubWriter) prefix() string {
+	if p.dryRun {
+		return api.DRYRUN_PREFIX
+	}
+	return ""
+}
+
+func (p *githubWriter) buffer(group string, line string) {
+	if _, ok := p.groups[group]; !ok {
+		p.pending = append(p.pending, group)
+	}
+	p.groups[group] = append(p.groups[group], line)
+}
+
+// flush prints buffered lines for a resource as a log group, and annotates failure
+func (p *githubWriter) flush(group string, e Event) {
+	lines, ok := p.groups[group]
+	if ok {
+		fmt.Fprintf(p.out, "::group::%s\n", escapeData(joinNonEmpty(group, e.StatusText)))
+		for _, l := range lines {
+			fmt.Fprintln(p.out, l)
+		}
+		fmt.Fprintln(p.out, "::endgroup::")
+		delete(p.groups, group)
+		for i, g := range p.pending {
+			if g == group {
+				p.pending = append(p.pending[:i], p.pending[i+1:]...)
+				break
+			}
+		}
+	}
+	if e.Status == Error {
+		msg := e.Details
+		if msg == "" {
+			msg = e.StatusText
+		}
+		fmt.Fprintf(p.out, "::error title=%s::%s\n", escapeProperty(group), escapeData(msg))
+	}
+}
+
+// flushAll prints resources which did not complete, in order of first appearance
+func (p *githubWriter) flushAll() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for len(p.pending) > 0 {
+		group := p.pending[0]
+		p.flush(group, p.last[group])
+	}
+}
+
+func joinNonEmpty(parts ...string) string {
+	var s []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			s = append(s, p)
+		}
+	}
+	return strings.Join(s, " ")
+}
+
+// escapeData escapes a workflow command message, see
+// https://github.com/actions/toolkit/blob/main/packages/core/src/command.ts
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes a workflow command property value
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
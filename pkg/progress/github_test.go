@@ -0,0 +1,66 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestGitHubWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := newGitHubWriter(&out, false)
+
+	w.Event(CreatingEvent("Network net"))
+	w.Event(CreatingEvent("Container a"))
+	w.Event(CreatedEvent("Network net"))
+	w.Log("Container a", "starting")
+	w.Event(ErrorDetailsEvent("Container a", errors.New("failed: port 80\nalready allocated")))
+	w.Event(Event{ID: "layer", ParentID: "Image b", Status: Working, Text: "Downloading"})
+	w.TailMsgf("done")
+
+	errs := make(chan error)
+	go func() {
+		errs <- w.Start(context.Background())
+	}()
+	w.Stop()
+	assert.NilError(t, <-errs)
+
+	assert.Equal(t, out.String(), `::group::Network net Created
+Network net Creating
+Network net Created
+::endgroup::
+::group::Container a Error
+Container a Creating
+Container a | starting
+Container a Error
+::endgroup::
+::error title=Container a::failed: port 80%0Aalready allocated
+done
+::group::Image b
+layer Downloading
+::endgroup::
+`)
+}
+
+func TestEscapeProperty(t *testing.T) {
+	assert.Equal(t, escapeProperty("a:b,c%"), "a%3Ab%2Cc%25")
+}
@@ -0,0 +1,60 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+// GroupWriter returns a Writer which assigns events to a named section, so that related
+// resources (networks, volumes, containers...) are rendered together by the tty writer
+func GroupWriter(w Writer, group string) Writer {
+	return &groupWriter{Writer: w, group: group}
+}
+
+type groupWriter struct {
+	Writer
+	group string
+}
+
+func (g *groupWriter) Event(e Event) {
+	g.Writer.Event(g.assign(e))
+}
+
+func (g *groupWriter) Events(events []Event) {
+	grouped := make([]Event, len(events))
+	for i, e := range events {
+		grouped[i] = g.assign(e)
+	}
+	g.Writer.Events(grouped)
+}
+
+func (g *groupWriter) Log(id string, line string) {
+	if l, ok := g.Writer.(Logger); ok {
+		l.Log(id, line)
+	}
+}
+
+func (g *groupWriter) Summary() Summary {
+	if s, ok := g.Writer.(Summarizer); ok {
+		return s.Summary()
+	}
+	return nil
+}
+
+func (g *groupWriter) assign(e Event) Event {
+	if e.Group == "" {
+		e.Group = g.group
+	}
+	return e
+}
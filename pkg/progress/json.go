@@ -0,0 +1,133 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+type jsonWriter struct {
+	out     io.Writer
+	done    chan bool
+	dryRun  bool
+	mtx     *sync.Mutex
+	timings *timings
+}
+
+type jsonMessage struct {
+	Timestamp  time.Time `json:"timestamp"`
+	DryRun     bool      `json:"dry-run,omitempty"`
+	Tail       bool      `json:"tail,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	ParentID   string    `json:"parent_id,omitempty"`
+	Group      string    `json:"group,omitempty"`
+	Text       string    `json:"text,omitempty"`
+	Status     string    `json:"status,omitempty"`
+	StatusText string    `json:"status_text,omitempty"`
+	Details    string    `json:"details,omitempty"`
+	Current    int64     `json:"current,omitempty"`
+	Total      int64     `json:"total,omitempty"`
+	Percent    int       `json:"percent,omitempty"`
+	Elapsed    float64   `json:"elapsed,omitempty"`
+	Log        string    `json:"log,omitempty"`
+}
+
+func (p *jsonWriter) Start(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.done:
+		return nil
+	}
+}
+
+func (p *jsonWriter) Event(e Event) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	now := time.Now()
+	p.timings.record(e, now)
+	message := &jsonMessage{
+		DryRun:     p.dryRun,
+		Tail:       false,
+		ID:         e.ID,
+		ParentID:   e.ParentID,
+		Group:      e.Group,
+		Text:       e.Text,
+		Status:     e.Status.String(),
+		StatusText: e.StatusText,
+		Details:    e.Details,
+		Current:    e.Current,
+		Total:      e.Total,
+		Percent:    e.Percent,
+		Elapsed:    now.Sub(p.timings.start(e.ID)).Seconds(),
+	}
+	p.write(message)
+}
+
+func (p *jsonWriter) Events(events []Event) {
+	for _, e := range events {
+		p.Event(e)
+	}
+}
+
+func (p *jsonWriter) TailMsgf(msg string, args ...interface{}) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	message := &jsonMessage{
+		DryRun: p.dryRun,
+		Tail:   true,
+		ID:     "",
+		Text:   fmt.Sprintf(msg, args...),
+		Status: "",
+	}
+	p.write(message)
+}
+
+func (p *jsonWriter) Log(id string, line string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	message := &jsonMessage{
+		DryRun: p.dryRun,
+		ID:     id,
+		Log:    line,
+	}
+	p.write(message)
+}
+
+func (p *jsonWriter) write(message *jsonMessage) {
+	// event log is appended to across runs, timestamp tells them apart
+	message.Timestamp = time.Now()
+	marshal, err := json.Marshal(message)
+	if err == nil {
+		fmt.Fprintln(p.out, string(marshal))
+	}
+}
+
+func (p *jsonWriter) Summary() Summary {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.timings.summary()
+}
+
+func (p *jsonWriter) Stop() {
+	p.done <- true
+}
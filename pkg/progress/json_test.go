@@ -0,0 +1,100 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestJsonWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := &jsonWriter{
+		out:     &out,
+		done:    make(chan bool),
+		dryRun:  true,
+		mtx:     &sync.Mutex{},
+		timings: newTimings(),
+	}
+
+	event := Event{
+		ID:         "service1",
+		ParentID:   "project",
+		Text:       "Creating",
+		StatusText: "Pulling",
+		Current:    50,
+		Total:      100,
+		Percent:    50,
+	}
+	w.Event(event)
+	w.TailMsgf("All %s", "done")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	assert.Equal(t, len(lines), 2)
+
+	var actual jsonMessage
+	err := json.Unmarshal(lines[0], &actual)
+	assert.NilError(t, err)
+	assert.Assert(t, !actual.Timestamp.IsZero())
+	actual.Timestamp = time.Time{}
+	assert.DeepEqual(t, jsonMessage{
+		DryRun:     true,
+		ID:         event.ID,
+		ParentID:   event.ParentID,
+		Text:       "Creating",
+		Status:     "Working",
+		StatusText: "Pulling",
+		Current:    50,
+		Total:      100,
+		Percent:    50,
+	}, actual)
+
+	var tail jsonMessage
+	err = json.Unmarshal(lines[1], &tail)
+	assert.NilError(t, err)
+	assert.Assert(t, !tail.Timestamp.IsZero())
+	tail.Timestamp = time.Time{}
+	assert.DeepEqual(t, jsonMessage{
+		DryRun: true,
+		Tail:   true,
+		Text:   "All done",
+	}, tail)
+}
+
+func TestJsonWriterErrorDetails(t *testing.T) {
+	var out bytes.Buffer
+	w := &jsonWriter{
+		out:     &out,
+		done:    make(chan bool),
+		mtx:     &sync.Mutex{},
+		timings: newTimings(),
+	}
+
+	w.Event(ErrorDetailsEvent("network", errors.New("pool overlaps with other one on this address space")))
+
+	var actual jsonMessage
+	err := json.Unmarshal(bytes.TrimSpace(out.Bytes()), &actual)
+	assert.NilError(t, err)
+	assert.Equal(t, actual.Status, "Error")
+	assert.Equal(t, actual.Details, "pool overlaps with other one on this address space")
+}
@@ -36,5 +36,12 @@ func (p *noopWriter) Events([]Event) {
 func (p *noopWriter) TailMsgf(_ string, _ ...interface{}) {
 }
 
+func (p *noopWriter) Log(_ string, _ string) {
+}
+
+func (p *noopWriter) Summary() Summary {
+	return nil
+}
+
 func (p *noopWriter) Stop() {
 }
@@ -20,14 +20,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/docker/compose/v2/pkg/api"
 )
 
 type plainWriter struct {
-	out    io.Writer
-	done   chan bool
-	dryRun bool
+	out     io.Writer
+	done    chan bool
+	dryRun  bool
+	mtx     *sync.Mutex
+	last    map[string]Event
+	timings *timings
 }
 
 func (p *plainWriter) Start(ctx context.Context) error {
@@ -40,6 +45,13 @@ func (p *plainWriter) Start(ctx context.Context) error {
 }
 
 func (p *plainWriter) Event(e Event) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.timings.record(e, time.Now())
+	if last, ok := p.last[e.ID]; ok && !isTransition(last, e) {
+		return
+	}
+	p.last[e.ID] = e
 	prefix := ""
 	if p.dryRun {
 		prefix = api.DRYRUN_PREFIX
@@ -47,6 +59,18 @@ func (p *plainWriter) Event(e Event) {
 	fmt.Fprintln(p.out, prefix, e.ID, e.Text, e.StatusText)
 }
 
+// isTransition checks if e changes the state of a resource compared to the last printed event
+func isTransition(last Event, e Event) bool {
+	if last.Status != e.Status || last.Text != e.Text {
+		return true
+	}
+	// progress updates within the same phase only change counters
+	if e.Total > 0 {
+		return false
+	}
+	return last.StatusText != e.StatusText
+}
+
 func (p *plainWriter) Events(events []Event) {
 	for _, e := range events {
 		p.Event(e)
@@ -61,6 +85,18 @@ func (p *plainWriter) TailMsgf(msg string, args ...interface{}) {
 	fmt.Fprintln(p.out, msg)
 }
 
+func (p *plainWriter) Log(id string, line string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	fmt.Fprintf(p.out, "%s | %s\n", id, line)
+}
+
+func (p *plainWriter) Summary() Summary {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.timings.summary()
+}
+
 func (p *plainWriter) Stop() {
 	p.done <- true
 }
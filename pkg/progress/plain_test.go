@@ -0,0 +1,92 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPlainWriterTransitions(t *testing.T) {
+	var out bytes.Buffer
+	w := &plainWriter{
+		out:     &out,
+		done:    make(chan bool),
+		mtx:     &sync.Mutex{},
+		last:    map[string]Event{},
+		timings: newTimings(),
+	}
+
+	w.Event(CreatingEvent("container"))
+	w.Event(CreatingEvent("container"))
+	w.Event(CreatedEvent("container"))
+	w.Event(StartingEvent("container"))
+	w.Event(StartedEvent("container"))
+
+	layer := Event{ID: "layer", Text: "Downloading", Status: Working, StatusText: "[=>  ]", Current: 1, Total: 10}
+	w.Event(layer)
+	layer.StatusText = "[===>]"
+	layer.Current = 5
+	w.Event(layer)
+	layer.Text = "Pull complete"
+	layer.Status = Done
+	w.Event(layer)
+
+	assert.Equal(t, out.String(), ` container  Creating
+ container  Created
+ container  Starting
+ container  Started
+ layer Downloading [=>  ]
+ layer Pull complete [===>]
+`)
+}
+
+func TestPlainWriterSummary(t *testing.T) {
+	w := &plainWriter{
+		out:     io.Discard,
+		done:    make(chan bool),
+		mtx:     &sync.Mutex{},
+		last:    map[string]Event{},
+		timings: newTimings(),
+	}
+	w.Event(CreatingEvent("fast"))
+	w.Event(CreatingEvent("slow"))
+	w.Event(Event{ID: "layer", ParentID: "slow", Status: Working})
+	w.Event(CreatedEvent("fast"))
+	time.Sleep(10 * time.Millisecond)
+	w.Event(ErrorMessageEvent("slow", "failed"))
+
+	summary := w.Summary()
+	assert.Equal(t, len(summary), 2)
+	assert.Equal(t, summary[0].ID, "fast")
+	assert.Equal(t, summary[1].Status, Error)
+	assert.Assert(t, summary[1].Duration() >= 10*time.Millisecond)
+
+	var out bytes.Buffer
+	summary.Print(&out)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, len(lines), 3)
+	assert.DeepEqual(t, strings.Fields(lines[0]), []string{"RESOURCE", "STATUS", "DURATION"})
+	assert.DeepEqual(t, strings.Fields(lines[1])[:2], []string{"slow", "Error"})
+	assert.DeepEqual(t, strings.Fields(lines[2])[:2], []string{"fast", "Done"})
+}
@@ -16,9 +16,15 @@
 
 package progress
 
-import "context"
-
-type quiet struct{}
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+type quiet struct {
+	out io.Writer
+}
 
 func (q quiet) Start(_ context.Context) error {
 	return nil
@@ -27,11 +33,29 @@ func (q quiet) Start(_ context.Context) error {
 func (q quiet) Stop() {
 }
 
-func (q quiet) Event(_ Event) {
+func (q quiet) Event(e Event) {
+	// errors are the only events surfaced in quiet mode
+	if e.Status == Error && q.out != nil {
+		msg := e.StatusText
+		if e.Details != "" {
+			msg = e.Details
+		}
+		fmt.Fprintln(q.out, e.ID, msg)
+	}
 }
 
-func (q quiet) Events(_ []Event) {
+func (q quiet) Events(events []Event) {
+	for _, e := range events {
+		q.Event(e)
+	}
 }
 
 func (q quiet) TailMsgf(_ string, _ ...interface{}) {
 }
+
+func (q quiet) Log(_ string, _ string) {
+}
+
+func (q quiet) Summary() Summary {
+	return nil
+}
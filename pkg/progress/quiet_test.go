@@ -0,0 +1,37 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestQuietWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := quiet{out: &out}
+
+	w.Event(CreatingEvent("network"))
+	w.Event(CreatedEvent("network"))
+	w.Event(ErrorEvent("volume"))
+	w.Event(ErrorDetailsEvent("container", errors.New("port is already allocated")))
+
+	assert.Equal(t, out.String(), "volume Error\ncontainer port is already allocated\n")
+}
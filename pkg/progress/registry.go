@@ -0,0 +1,69 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// WriterFactory creates a Writer rendering progress events to out
+type WriterFactory func(out io.Writer, dryRun bool, progressTitle string) (Writer, error)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = map[string]WriterFactory{}
+)
+
+// RegisterWriter makes a custom Writer available as a progress Mode, so it can be selected by name
+// with `--progress`. It panics if name is already registered or conflicts with a built-in Mode.
+func RegisterWriter(name string, factory WriterFactory) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+	if factory == nil {
+		panic("progress: RegisterWriter factory is nil")
+	}
+	switch name {
+	case ModeAuto, ModeTTY, ModeTTYASCII, ModePlain, ModeQuiet, ModeJSON:
+		panic(fmt.Sprintf("progress: RegisterWriter can't override built-in mode %q", name))
+	}
+	if _, dup := registry[name]; dup {
+		panic(fmt.Sprintf("progress: RegisterWriter called twice for %q", name))
+	}
+	registry[name] = factory
+}
+
+// RegisteredWriters lists names of the custom Writers, sorted
+func RegisteredWriters() []string {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func registeredWriter(name string) (WriterFactory, bool) {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+	factory, ok := registry[name]
+	return factory, ok
+}
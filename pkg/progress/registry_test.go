@@ -0,0 +1,73 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+type recordingWriter struct {
+	noopWriter
+	title  string
+	events []Event
+}
+
+func (r *recordingWriter) Event(e Event) {
+	r.events = append(r.events, e)
+}
+
+func TestRegisterWriter(t *testing.T) {
+	recorder := &recordingWriter{}
+	RegisterWriter("test-gui", func(out io.Writer, dryRun bool, progressTitle string) (Writer, error) {
+		recorder.title = progressTitle
+		return recorder, nil
+	})
+	defer func(mode string) {
+		Mode = mode
+		registryMtx.Lock()
+		delete(registry, "test-gui")
+		registryMtx.Unlock()
+	}(Mode)
+
+	assert.DeepEqual(t, RegisteredWriters(), []string{"test-gui"})
+
+	Mode = "test-gui"
+	w, err := NewWriter(context.TODO(), &bytes.Buffer{}, "Running")
+	assert.NilError(t, err)
+	w.Event(CreatingEvent("service"))
+	assert.Equal(t, recorder.title, "Running")
+	assert.Equal(t, len(recorder.events), 1)
+	assert.Equal(t, recorder.events[0].StatusText, "Creating")
+
+	assert.Assert(t, panics(func() { RegisterWriter("test-gui", nil) }))
+	assert.Assert(t, panics(func() {
+		RegisterWriter(ModeTTY, func(io.Writer, bool, string) (Writer, error) { return nil, nil })
+	}))
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
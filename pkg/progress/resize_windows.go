@@ -0,0 +1,53 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"time"
+
+	"github.com/buger/goterm"
+)
+
+// watchResize notifies resized when terminal window size changes.
+// Windows has no SIGWINCH equivalent, so console size is polled.
+func watchResize(resized chan<- struct{}) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		width, height := goterm.Width(), goterm.Height()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				w, h := goterm.Width(), goterm.Height()
+				if w == width && h == height {
+					continue
+				}
+				width, height = w, h
+				select {
+				case resized <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+	}
+}
@@ -17,10 +17,57 @@
 package progress
 
 import (
+	"fmt"
 	"runtime"
 	"time"
 )
 
+// SpinnerStyle selects the set of glyphs used to render a spinner
+type SpinnerStyle string
+
+const (
+	// SpinnerDefault uses braille glyphs, but a static dash on Windows
+	SpinnerDefault SpinnerStyle = ""
+	// SpinnerBraille uses unicode braille patterns
+	SpinnerBraille SpinnerStyle = "braille"
+	// SpinnerASCII only uses ASCII characters
+	SpinnerASCII SpinnerStyle = "ascii"
+	// SpinnerDots uses unicode dots of growing size
+	SpinnerDots SpinnerStyle = "dots"
+)
+
+// defaultSpinnerStyle is used when no spinner style has been selected, see ASCII
+var defaultSpinnerStyle = SpinnerDefault
+
+type spinnerGlyphs struct {
+	chars []string
+	done  string
+}
+
+var spinnerStyles = map[SpinnerStyle]spinnerGlyphs{
+	SpinnerBraille: {
+		chars: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		done:  "⠿",
+	},
+	SpinnerASCII: {
+		chars: []string{"|", "/", "-", "\\"},
+		done:  "-",
+	},
+	SpinnerDots: {
+		chars: []string{"⋅", "∙", "•", "●", "•", "∙"},
+		done:  "●",
+	},
+}
+
+// ParseSpinnerStyle checks s is a supported spinner style
+func ParseSpinnerStyle(s string) (SpinnerStyle, error) {
+	style := SpinnerStyle(s)
+	if _, ok := spinnerStyles[style]; !ok && style != SpinnerDefault {
+		return SpinnerDefault, fmt.Errorf("unsupported spinner style %q", s)
+	}
+	return style, nil
+}
+
 type spinner struct {
 	time  time.Time
 	index int
@@ -29,16 +76,22 @@ type spinner struct {
 	done  string
 }
 
-func newSpinner() *spinner {
-	chars := []string{
-		"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏",
+func newSpinner(style SpinnerStyle) *spinner {
+	if style == SpinnerDefault {
+		style = defaultSpinnerStyle
 	}
-	done := "⠿"
-
-	if runtime.GOOS == "windows" {
-		chars = []string{"-"}
-		done = "-"
+	glyphs, ok := spinnerStyles[style]
+	if !ok {
+		glyphs = spinnerStyles[SpinnerBraille]
+		if runtime.GOOS == "windows" {
+			glyphs = spinnerGlyphs{
+				chars: []string{"-"},
+				done:  "-",
+			}
+		}
 	}
+	chars := glyphs.chars
+	done := glyphs.done
 
 	return &spinner{
 		index: 0,
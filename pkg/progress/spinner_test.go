@@ -0,0 +1,65 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSpinnerStyles(t *testing.T) {
+	s := newSpinner(SpinnerASCII)
+	assert.DeepEqual(t, s.chars, []string{"|", "/", "-", "\\"})
+	s.Stop()
+	assert.Equal(t, s.String(), "-")
+
+	s = newSpinner(SpinnerDots)
+	assert.Equal(t, s.String(), "⋅")
+}
+
+func TestParseSpinnerStyle(t *testing.T) {
+	style, err := ParseSpinnerStyle("dots")
+	assert.NilError(t, err)
+	assert.Equal(t, style, SpinnerDots)
+
+	style, err = ParseSpinnerStyle("")
+	assert.NilError(t, err)
+	assert.Equal(t, style, SpinnerDefault)
+
+	_, err = ParseSpinnerStyle("stars")
+	assert.Error(t, err, `unsupported spinner style "stars"`)
+}
+
+func TestASCII(t *testing.T) {
+	defer func(done, errored, ellipsisChars string, percent []string, style SpinnerStyle) {
+		spinnerDone, spinnerError, ellipsis, percentChars, defaultSpinnerStyle = done, errored, ellipsisChars, percent, style
+	}(spinnerDone, spinnerError, ellipsis, percentChars, defaultSpinnerStyle)
+	ASCII()
+
+	s := newSpinner(SpinnerDefault)
+	assert.DeepEqual(t, s.chars, []string{"|", "/", "-", "\\"})
+	assert.Equal(t, newSpinner(SpinnerDots).done, "●")
+
+	assert.Equal(t, len(percentChars), 9)
+	assert.Equal(t, truncate("a long status text", 10), "a long ...")
+
+	line := tty().lineText(Event{ID: "id", Status: Done, StatusText: "Created"}, "", 50, 10, false)
+	for _, r := range line {
+		assert.Assert(t, r < 128, "non-ASCII character %q in %q", r, line)
+	}
+}
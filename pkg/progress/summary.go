@@ -0,0 +1,161 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// ResourceTiming reports time spent on a resource tracked by progress events
+type ResourceTiming struct {
+	ID     string
+	Status EventStatus
+	Start  time.Time
+	End    time.Time
+}
+
+// Duration returns time spent on resource, zero if still in progress
+func (r ResourceTiming) Duration() time.Duration {
+	if r.End.IsZero() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Summary lists timings for top-level resources, in the order they were first reported
+type Summary []ResourceTiming
+
+type summaryKey struct{}
+
+type summaryCollector struct {
+	mtx     sync.Mutex
+	summary Summary
+}
+
+// WithSummary returns a context collecting timings reported by all Run functions executed with it,
+// including nested ones, and a function to retrieve the collected Summary once they completed
+func WithSummary(ctx context.Context) (context.Context, func() Summary) {
+	c := &summaryCollector{}
+	return context.WithValue(ctx, summaryKey{}, c), func() Summary {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+		return c.summary
+	}
+}
+
+func collectSummary(ctx context.Context, s Summary) {
+	c, ok := ctx.Value(summaryKey{}).(*summaryCollector)
+	if !ok {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.summary = append(c.summary, s...)
+}
+
+// Print renders summary as a table, slowest resources first
+func (s Summary) Print(out io.Writer) {
+	if len(s) == 0 {
+		return
+	}
+	sorted := make(Summary, len(s))
+	copy(sorted, s)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Duration() > sorted[j].Duration()
+	})
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tSTATUS\tDURATION")
+	for _, r := range sorted {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.ID, r.Status, formatElapsed(r.Duration(), false))
+	}
+	_ = w.Flush()
+}
+
+// formatElapsed renders a duration for humans: seconds with one decimal under a minute, then
+// minutes and hours. When precise is set, durations under a second are rendered in milliseconds
+func formatElapsed(d time.Duration, precise bool) string {
+	switch {
+	case d < 0:
+		return formatElapsed(0, precise)
+	case precise && d < time.Second:
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	case d < time.Minute:
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// timings records start and end time of resources for writers which don't keep track of events
+type timings struct {
+	ids       []string
+	resources map[string]ResourceTiming
+	children  map[string]bool
+}
+
+func newTimings() *timings {
+	return &timings{
+		resources: map[string]ResourceTiming{},
+		children:  map[string]bool{},
+	}
+}
+
+func (t *timings) record(e Event, now time.Time) {
+	r, ok := t.resources[e.ID]
+	if !ok {
+		t.ids = append(t.ids, e.ID)
+		r = ResourceTiming{
+			ID:    e.ID,
+			Start: now,
+		}
+	}
+	if e.ParentID != "" {
+		t.children[e.ID] = true
+	}
+	if !r.End.IsZero() {
+		// already done, don't overwrite
+		return
+	}
+	r.Status = e.Status
+	if e.Status != Working {
+		r.End = now
+	}
+	t.resources[e.ID] = r
+}
+
+func (t *timings) start(id string) time.Time {
+	return t.resources[id].Start
+}
+
+func (t *timings) summary() Summary {
+	var s Summary
+	for _, id := range t.ids {
+		if t.children[id] {
+			continue
+		}
+		s = append(s, t.resources[id])
+	}
+	return s
+}
@@ -0,0 +1,62 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+// teeWriter renders events with a Writer and forwards a copy to a secondary writer.
+// Only the primary Writer lifecycle is managed by Start and Stop, so secondary must not
+// require a running loop to process events.
+type teeWriter struct {
+	Writer
+	secondary Writer
+}
+
+func (t *teeWriter) Event(e Event) {
+	t.Writer.Event(e)
+	t.secondary.Event(e)
+}
+
+func (t *teeWriter) Events(events []Event) {
+	t.Writer.Events(events)
+	t.secondary.Events(events)
+}
+
+func (t *teeWriter) TailMsgf(msg string, args ...interface{}) {
+	t.Writer.TailMsgf(msg, args...)
+	t.secondary.TailMsgf(msg, args...)
+}
+
+func (t *teeWriter) Log(id string, line string) {
+	if l, ok := t.Writer.(Logger); ok {
+		l.Log(id, line)
+	}
+	if l, ok := t.secondary.(Logger); ok {
+		l.Log(id, line)
+	}
+}
+
+func (t *teeWriter) Summary() Summary {
+	if s, ok := t.Writer.(Summarizer); ok {
+		return s.Summary()
+	}
+	return nil
+}
+
+func (t *teeWriter) Cancel() {
+	if c, ok := t.Writer.(canceler); ok {
+		c.Cancel()
+	}
+}
@@ -0,0 +1,139 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingWriter bridges progress events to OpenTelemetry: a span is started when a resource
+// starts working and ended with matching status once it completes. Children events are
+// traced as child spans of their parent when it is in progress.
+type tracingWriter struct {
+	Writer
+	ctx    context.Context
+	tracer trace.Tracer
+	mtx    sync.Mutex
+	spans  map[string]*tracedEvent
+}
+
+type tracedEvent struct {
+	ctx  context.Context
+	span trace.Span
+	text string
+}
+
+func newTracingWriter(ctx context.Context, w Writer, tracer trace.Tracer) *tracingWriter {
+	return &tracingWriter{
+		Writer: w,
+		ctx:    ctx,
+		tracer: tracer,
+		spans:  map[string]*tracedEvent{},
+	}
+}
+
+func (t *tracingWriter) Event(e Event) {
+	t.trace(e)
+	t.Writer.Event(e)
+}
+
+func (t *tracingWriter) Events(events []Event) {
+	for _, e := range events {
+		t.trace(e)
+	}
+	t.Writer.Events(events)
+}
+
+func (t *tracingWriter) Log(id string, line string) {
+	if l, ok := t.Writer.(Logger); ok {
+		l.Log(id, line)
+	}
+}
+
+func (t *tracingWriter) Summary() Summary {
+	if s, ok := t.Writer.(Summarizer); ok {
+		return s.Summary()
+	}
+	return nil
+}
+
+func (t *tracingWriter) Cancel() {
+	if c, ok := t.Writer.(canceler); ok {
+		c.Cancel()
+	}
+}
+
+// Stop ends spans for resources which never completed
+func (t *tracingWriter) Stop() {
+	t.Writer.Stop()
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for id, s := range t.spans {
+		s.span.End()
+		delete(t.spans, id)
+	}
+}
+
+func (t *tracingWriter) trace(e Event) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	s, ok := t.spans[e.ID]
+	if !ok {
+		parent := t.ctx
+		if p, ok := t.spans[e.ParentID]; ok {
+			parent = p.ctx
+		}
+		ctx, span := t.tracer.Start(parent, e.ID, trace.WithAttributes(
+			attribute.String("progress.id", e.ID),
+			attribute.String("progress.parent_id", e.ParentID),
+			attribute.String("progress.group", e.Group),
+		))
+		s = &tracedEvent{ctx: ctx, span: span}
+		t.spans[e.ID] = s
+	}
+	// record steps, but not StatusText updates used to render progress
+	text := e.Text
+	if text == "" {
+		text = e.StatusText
+	}
+	if text != s.text {
+		s.span.AddEvent(text)
+		s.text = text
+	}
+
+	switch e.Status {
+	case Working:
+		return
+	case Done, Warning, Skipped:
+		s.span.SetStatus(codes.Ok, "")
+	case Error:
+		msg := e.Details
+		if msg == "" {
+			msg = e.StatusText
+		}
+		s.span.SetStatus(codes.Error, msg)
+	case Canceled:
+		s.span.SetStatus(codes.Error, "canceled")
+	}
+	s.span.End()
+	delete(t.spans, e.ID)
+}
@@ -0,0 +1,94 @@
+/*
+   Copyright 2020 Docker Compose CLI authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gotest.tools/v3/assert"
+)
+
+type recordedSpan struct {
+	trace.Span
+	name   string
+	parent string
+	events []string
+	status codes.Code
+	desc   string
+	ended  bool
+}
+
+func (s *recordedSpan) AddEvent(name string, _ ...trace.EventOption) {
+	s.events = append(s.events, name)
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, description string) {
+	s.status = code
+	s.desc = description
+}
+
+func (s *recordedSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &recordedSpan{Span: trace.SpanFromContext(context.Background()), name: name}
+	if parent, ok := trace.SpanFromContext(ctx).(*recordedSpan); ok {
+		span.parent = parent.name
+	}
+	r.spans = append(r.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func TestTracingWriter(t *testing.T) {
+	tracer := &recordingTracer{}
+	w := newTracingWriter(context.Background(), &noopWriter{}, tracer)
+
+	w.Event(NewEvent("Image foo", Working, "Pulling"))
+	w.Event(Event{ID: "layer", ParentID: "Image foo", Status: Working, Text: "Downloading"})
+	w.Event(Event{ID: "layer", ParentID: "Image foo", Status: Done, Text: "Pull complete"})
+	w.Event(NewEvent("Image foo", Done, "Pulled"))
+	w.Events([]Event{CreatingEvent("Network bar"), ErrorDetailsEvent("Network bar", errors.New("permission denied"))})
+	w.Event(CreatingEvent("Container baz"))
+	w.Stop()
+
+	assert.Equal(t, len(tracer.spans), 4)
+	image, layer, network, container := tracer.spans[0], tracer.spans[1], tracer.spans[2], tracer.spans[3]
+
+	assert.Equal(t, image.name, "Image foo")
+	assert.DeepEqual(t, image.events, []string{"Pulling", "Pulled"})
+	assert.Equal(t, image.status, codes.Ok)
+	assert.Assert(t, image.ended)
+
+	assert.Equal(t, layer.parent, "Image foo")
+	assert.Equal(t, layer.status, codes.Ok)
+
+	assert.Equal(t, network.status, codes.Error)
+	assert.Equal(t, network.desc, "permission denied")
+	assert.Assert(t, network.ended)
+
+	assert.Equal(t, container.status, codes.Unset)
+	assert.Assert(t, container.ended)
+}
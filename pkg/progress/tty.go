@@ -25,10 +25,10 @@ import (
 	"time"
 
 	"github.com/docker/compose/v2/pkg/api"
-	"github.com/docker/compose/v2/pkg/utils"
 
 	"github.com/buger/goterm"
 	"github.com/docker/go-units"
+	"github.com/mattn/go-runewidth"
 	"github.com/morikuni/aec"
 )
 
@@ -38,52 +38,128 @@ type ttyWriter struct {
 	eventIDs        []string
 	repeated        bool
 	numLines        int
+	width           int
 	done            chan bool
 	mtx             *sync.Mutex
 	tailEvents      []string
+	logLines        []logLine
 	dryRun          bool
 	skipChildEvents bool
 	progressTitle   string
+	spinnerStyle    SpinnerStyle
+	refreshInterval time.Duration
+	dirty           bool
+	wake            chan struct{}
+	updates         coalescer
+	milliseconds    bool
+	finished        Summary
 }
 
+// maxRefreshInterval is the slowest refresh rate ttyWriter backs off to while idle
+const maxRefreshInterval = time.Second
+
+// Start renders events until Stop is called. Once ctx is done, in-flight events are rendered as
+// canceled, but rendering goes on so that errors reported by the interrupted operation are
+// displayed. Once it returns, the display is reset so that the writer can be started again for
+// a subsequent operation.
 func (w *ttyWriter) Start(ctx context.Context) error {
-	ticker := time.NewTicker(100 * time.Millisecond)
+	defer w.reset()
+	interval := w.refreshInterval
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	resized := make(chan struct{}, 1)
+	stopWatch := watchResize(resized)
+	defer stopWatch()
+
+	interrupted := ctx.Done()
 	for {
 		select {
-		case <-ctx.Done():
+		case <-interrupted:
+			interrupted = nil
+			w.Cancel()
 			w.print()
-			w.printTailEvents()
-			return ctx.Err()
 		case <-w.done:
 			w.print()
 			w.printTailEvents()
-			return nil
+			w.printErrorDetails()
+			w.printOutcome()
+			return ctx.Err()
 		case <-ticker.C:
+			if !w.needsRedraw() {
+				// nothing changed, back off until next event
+				if interval < maxRefreshInterval {
+					interval *= 2
+					if interval > maxRefreshInterval {
+						interval = maxRefreshInterval
+					}
+					ticker.Reset(interval)
+				}
+				continue
+			}
+			w.print()
+		case <-w.wake:
+			if interval != w.refreshInterval {
+				interval = w.refreshInterval
+				ticker.Reset(interval)
+			}
+		case <-resized:
 			w.print()
 		}
 	}
 }
 
+// Stop ends rendering. It never blocks: if Start isn't running yet, the next call to Start returns
+// as soon as pending events have been rendered.
 func (w *ttyWriter) Stop() {
-	w.done <- true
+	select {
+	case w.done <- true:
+	default:
+	}
+}
+
+// reset clears events once rendered, keeping their timings for Summary
+func (w *ttyWriter) reset() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for _, e := range w.updates.drain() {
+		w.event(e)
+	}
+	w.finished = w.summary()
+	w.events = map[string]Event{}
+	w.eventIDs = nil
+	w.repeated = false
+	w.numLines = 0
+	w.width = 0
+	w.skipChildEvents = false
+	w.dirty = false
+	w.tailEvents = nil
+	w.logLines = nil
+	w.updates.reset()
+	// discard redundant Stop calls received during this run
+	select {
+	case <-w.done:
+	default:
+	}
 }
 
 func (w *ttyWriter) Event(e Event) {
+	if w.updates.offer(e) {
+		return
+	}
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
 	w.event(e)
 }
 
 func (w *ttyWriter) event(e Event) {
-	if !utils.StringContains(w.eventIDs, e.ID) {
-		w.eventIDs = append(w.eventIDs, e.ID)
-	}
-	if _, ok := w.events[e.ID]; ok {
-		last := w.events[e.ID]
+	defer func() {
+		w.updates.track(e.ID, w.events[e.ID].Status == Working)
+	}()
+	if prev, ok := w.events[e.ID]; ok {
+		last := prev
 		switch e.Status {
-		case Done, Error, Warning:
+		case Done, Error, Warning, Canceled, Skipped:
 			if last.endTime.IsZero() {
 				last.stop()
 			}
@@ -103,21 +179,83 @@ func (w *ttyWriter) event(e Event) {
 		if last.ParentID == "" || e.ParentID == "" {
 			last.ParentID = e.ParentID
 		}
+		if e.Group != "" {
+			last.Group = e.Group
+		}
+		if e.Details != "" {
+			last.Details = e.Details
+		}
 		w.events[e.ID] = last
+		if last != prev {
+			w.markDirty()
+		}
 	} else {
 		e.startTime = time.Now()
-		e.spinner = newSpinner()
-		if e.Status == Done || e.Status == Error {
+		e.spinner = newSpinner(w.spinnerStyle)
+		if e.Status != Working {
 			e.stop()
 		}
 		w.events[e.ID] = e
+		w.eventIDs = append(w.eventIDs, e.ID)
+		w.markDirty()
 	}
 }
 
-func (w *ttyWriter) Events(events []Event) {
+// markDirty flags rendering as outdated and wakes up an idle render loop
+func (w *ttyWriter) markDirty() {
+	w.dirty = true
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// needsRedraw tells if an event changed since last rendering, or if a running spinner must be animated
+func (w *ttyWriter) needsRedraw() bool {
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
+	if w.dirty {
+		return true
+	}
+	for _, e := range w.events {
+		if e.Status == Working {
+			return true
+		}
+	}
+	return false
+}
+
+// Cancel renders in-flight events as canceled, as the operation has been interrupted
+func (w *ttyWriter) Cancel() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for _, e := range w.updates.drain() {
+		w.event(e)
+	}
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.Status != Working {
+			continue
+		}
+		e.Status = Canceled
+		e.StatusText = "Canceled"
+		e.stop()
+		w.events[id] = e
+		w.updates.track(id, false)
+		w.markDirty()
+	}
+}
+
+func (w *ttyWriter) Events(events []Event) {
+	var apply []Event
 	for _, e := range events {
+		if !w.updates.offer(e) {
+			apply = append(apply, e)
+		}
+	}
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for _, e := range apply {
 		w.event(e)
 	}
 }
@@ -132,6 +270,51 @@ func (w *ttyWriter) TailMsgf(msg string, args ...interface{}) {
 	w.tailEvents = append(w.tailEvents, fmt.Sprintf(msgWithPrefix, args...))
 }
 
+type logLine struct {
+	id   string
+	line string
+}
+
+// maxLogLines is the number of most recent log lines kept below the progress display
+const maxLogLines = 10
+
+func (w *ttyWriter) Log(id string, line string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.logLines = append(w.logLines, logLine{id: id, line: line})
+	if len(w.logLines) > maxLogLines {
+		w.logLines = w.logLines[len(w.logLines)-maxLogLines:]
+	}
+	w.markDirty()
+}
+
+// Summary reports timings for the current operation, or the last completed one
+func (w *ttyWriter) Summary() Summary {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if len(w.eventIDs) == 0 {
+		return w.finished
+	}
+	return w.summary()
+}
+
+func (w *ttyWriter) summary() Summary {
+	var s Summary
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.ParentID != "" {
+			continue
+		}
+		s = append(s, ResourceTiming{
+			ID:     e.ID,
+			Status: e.Status,
+			Start:  e.startTime,
+			End:    e.endTime,
+		})
+	}
+	return s
+}
+
 func (w *ttyWriter) printTailEvents() {
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
@@ -140,37 +323,177 @@ func (w *ttyWriter) printTailEvents() {
 	}
 }
 
+// printErrorDetails prints the full error message for each failed resource, as StatusText
+// only displays a short status
+func (w *ttyWriter) printErrorDetails() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	const indent = "    "
+	width := goterm.Width() - len(indent)
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.Status != Error || e.Details == "" {
+			continue
+		}
+		fmt.Fprintln(w.out, ErrorColor(fmt.Sprintf("%s %s:", spinnerError, e.ID)))
+		for _, line := range wrap(e.Details, width) {
+			fmt.Fprintln(w.out, indent+line)
+		}
+	}
+}
+
+// printOutcome prints a persistent line reporting the overall result, so it can be found when
+// scrolling back without reading every event
+func (w *ttyWriter) printOutcome() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if line := w.outcome(); line != "" {
+		fmt.Fprintln(w.out, line)
+	}
+}
+
+func (w *ttyWriter) outcome() string {
+	var (
+		total, errored, warnings, canceled, skipped int
+		start, end                                  time.Time
+	)
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.ParentID != "" {
+			continue
+		}
+		total++
+		switch e.Status {
+		case Error:
+			errored++
+		case Warning:
+			warnings++
+		case Canceled:
+			canceled++
+		case Skipped:
+			skipped++
+		}
+		if start.IsZero() || e.startTime.Before(start) {
+			start = e.startTime
+		}
+		if e.endTime.After(end) {
+			end = e.endTime
+		}
+	}
+	if total == 0 {
+		return ""
+	}
+	if end.Before(start) {
+		end = start
+	}
+
+	var details []string
+	if errored > 0 {
+		details = append(details, plural(errored, "error"))
+	}
+	if canceled > 0 {
+		details = append(details, fmt.Sprintf("%d canceled", canceled))
+	}
+	if warnings > 0 {
+		details = append(details, plural(warnings, "warning"))
+	}
+	if skipped > 0 {
+		details = append(details, fmt.Sprintf("%d unchanged", skipped))
+	}
+	line := fmt.Sprintf("%s done in %s", plural(total, "resource"), formatElapsed(end.Sub(start), w.milliseconds))
+	if len(details) > 0 {
+		line = fmt.Sprintf("%s (%s)", line, strings.Join(details, ", "))
+	}
+	if errored > 0 || canceled > 0 {
+		return ErrorColor(fmt.Sprintf("%s %s", spinnerError, line))
+	}
+	return SuccessColor(fmt.Sprintf("%s %s", spinnerDone, line))
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// wrap splits text in lines no longer than width, breaking on whitespace when possible
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			switch {
+			case line == "":
+				line = word
+			case width > 0 && lenAnsi(line)+1+lenAnsi(word) > width:
+				lines = append(lines, line)
+				line = word
+			default:
+				line += " " + word
+			}
+			for width > 0 && lenAnsi(line) > width {
+				head, tail := cut(line, width)
+				if tail == "" {
+					break
+				}
+				lines = append(lines, head)
+				line = tail
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// cut splits text on a rune boundary so that head fits within width columns. head always
+// holds at least one rune, so that a wide character never blocks progress
+func cut(text string, width int) (head string, tail string) {
+	length := 0
+	for i, r := range text {
+		rw := runewidth.RuneWidth(r)
+		if i > 0 && length+rw > width {
+			return text[:i], text[i:]
+		}
+		length += rw
+	}
+	return text, ""
+}
+
 func (w *ttyWriter) print() { //nolint:gocyclo
 	w.mtx.Lock()
 	defer w.mtx.Unlock()
+	for _, e := range w.updates.drain() {
+		w.event(e)
+	}
 	if len(w.eventIDs) == 0 {
 		return
 	}
-	terminalWidth := goterm.Width()
+	w.dirty = false
+	terminalWidth := termWidth()
 	b := aec.EmptyBuilder
-	for i := 0; i <= w.numLines; i++ {
+	for i := 0; i < w.previousLines(terminalWidth); i++ {
 		b = b.Up(1)
 	}
 	if !w.repeated {
 		b = b.Down(1)
 	}
 	w.repeated = true
+	if w.width != terminalWidth {
+		// terminal has been resized, clear previous rendering before redrawing the whole block
+		b = b.Column(0).EraseDisplay(aec.EraseModes.Tail)
+		w.width = terminalWidth
+	}
 	fmt.Fprint(w.out, b.Column(0).ANSI)
 
 	// Hide the cursor while we are printing
 	fmt.Fprint(w.out, aec.Hide)
 	defer fmt.Fprint(w.out, aec.Show)
 
-	firstLine := fmt.Sprintf("[+] %s %d/%d", w.progressTitle, numDone(w.events), w.numLines)
-	if w.numLines != 0 && numDone(w.events) == w.numLines {
-		firstLine = DoneColor(firstLine)
-	}
-	fmt.Fprintln(w.out, firstLine)
-
 	var statusPadding int
 	for _, v := range w.eventIDs {
 		event := w.events[v]
-		l := len(fmt.Sprintf("%s %s", event.ID, event.Text))
+		l := lenAnsi(fmt.Sprintf("%s %s", event.ID, event.Text))
 		if statusPadding < l {
 			statusPadding = l
 		}
@@ -182,34 +505,138 @@ func (w *ttyWriter) print() { //nolint:gocyclo
 	if len(w.eventIDs) > goterm.Height()-2 {
 		w.skipChildEvents = true
 	}
+	hidden := w.viewport(goterm.Height() - 2)
+	numLines := 0
+	for i, group := range w.groups() {
+		if i > 0 {
+			// first header line is not included in numLines, see cursor move above
+			numLines++
+		}
+		fmt.Fprint(w.out, w.headerText(group, terminalWidth))
+		numLines += w.printGroup(group, terminalWidth, statusPadding, hidden)
+	}
+	if len(hidden) > 0 {
+		fmt.Fprint(w.out, align(fmt.Sprintf("(and %d more done)", len(hidden)), "", terminalWidth))
+		numLines++
+	}
+	for _, l := range w.logLines {
+		fmt.Fprint(w.out, logLineText(l, terminalWidth))
+		numLines++
+	}
+	for i := numLines; i < w.numLines; i++ {
+		if numLines < goterm.Height()-2 {
+			fmt.Fprintln(w.out, strings.Repeat(" ", terminalWidth))
+			numLines++
+		}
+	}
+	w.numLines = numLines
+}
+
+// groups lists the sections of top-level events, in order of first appearance
+func (w *ttyWriter) groups() []string {
+	var groups []string
+	seen := map[string]bool{}
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.ParentID != "" || seen[e.Group] {
+			continue
+		}
+		seen[e.Group] = true
+		groups = append(groups, e.Group)
+	}
+	return groups
+}
+
+func (w *ttyWriter) headerText(group string, terminalWidth int) string {
+	title := group
+	if title == "" {
+		title = w.progressTitle
+	}
+	done, total := numDone(w.events, group)
+	header := fmt.Sprintf("[+] %s %d/%d", title, done, total)
+	if total != 0 && done == total {
+		header = DoneColor(header)
+	}
+	return align(header, "", terminalWidth)
+}
+
+// viewport selects the completed top-level events to hide, oldest first, so that rendering
+// fits within maxLines. Active events are always displayed. Terminal height is unknown when
+// maxLines is not positive, as goterm measures stdout which might be redirected.
+func (w *ttyWriter) viewport(maxLines int) map[string]bool {
+	if maxLines <= 0 {
+		return nil
+	}
+	lines := len(w.groups()) + len(w.logLines)
+	for _, id := range w.eventIDs {
+		e := w.events[id]
+		if e.ParentID == "" {
+			lines++
+			continue
+		}
+		if !w.skipChildEvents && !collapsed(w.events[e.ParentID]) {
+			lines++
+		}
+	}
+	if lines <= maxLines {
+		return nil
+	}
+	hidden := map[string]bool{}
+	lines++ // footer
+	for _, id := range w.eventIDs {
+		if lines <= maxLines {
+			break
+		}
+		e := w.events[id]
+		if e.ParentID == "" && collapsed(e) {
+			hidden[id] = true
+			lines--
+		}
+	}
+	return hidden
+}
+
+// collapsed tells if an event completed successfully, so that its children can be hidden.
+// Children are kept visible on error for diagnosis
+func collapsed(e Event) bool {
+	return e.Status == Done || e.Status == Skipped
+}
+
+// printGroup prints events for a section and returns the number of printed lines
+func (w *ttyWriter) printGroup(group string, terminalWidth, statusPadding int, hidden map[string]bool) int {
 	numLines := 0
 	for _, v := range w.eventIDs {
 		event := w.events[v]
-		if event.ParentID != "" {
+		if event.ParentID != "" || event.Group != group || hidden[v] {
 			continue
 		}
 		line := w.lineText(event, "", terminalWidth, statusPadding, w.dryRun)
 		fmt.Fprint(w.out, line)
 		numLines++
+		if w.skipChildEvents || collapsed(event) {
+			continue
+		}
 		for _, v := range w.eventIDs {
 			ev := w.events[v]
 			if ev.ParentID == event.ID {
-				if w.skipChildEvents {
-					continue
-				}
 				line := w.lineText(ev, "  ", terminalWidth, statusPadding, w.dryRun)
 				fmt.Fprint(w.out, line)
 				numLines++
 			}
 		}
 	}
-	for i := numLines; i < w.numLines; i++ {
-		if numLines < goterm.Height()-2 {
-			fmt.Fprintln(w.out, strings.Repeat(" ", terminalWidth))
-			numLines++
-		}
+	return numLines
+}
+
+// previousLines computes the number of terminal rows used by last rendering, including the
+// header line. Lines are padded to terminal width, so they wrap on multiple rows after terminal
+// has been shrunk
+func (w *ttyWriter) previousLines(terminalWidth int) int {
+	if w.width <= terminalWidth || terminalWidth <= 0 {
+		return w.numLines + 1
 	}
-	w.numLines = numLines
+	rows := (w.width + terminalWidth - 1) / terminalWidth
+	return (w.numLines + 1) * rows
 }
 
 func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPadding int, dryRun bool) string {
@@ -225,7 +652,7 @@ func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPaddi
 		prefix = PrefixColor(api.DRYRUN_PREFIX)
 	}
 
-	elapsed := endTime.Sub(event.startTime).Seconds()
+	elapsed := endTime.Sub(event.startTime)
 
 	var (
 		total      int64
@@ -243,6 +670,7 @@ func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPaddi
 	}
 
 	var txt string
+	status := event.StatusText
 	if len(completion) > 0 {
 		txt = fmt.Sprintf("%s %s [%s] %7s/%-7s %s",
 			event.ID,
@@ -250,10 +678,18 @@ func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPaddi
 			SuccessColor(strings.Join(completion, "")),
 			units.HumanSize(float64(current)), units.HumanSize(float64(total)),
 			event.Text)
+	} else if event.Total > 0 {
+		txt = fmt.Sprintf("%s %s [%s] %7s/%-7s",
+			event.ID,
+			event.Text,
+			progressBar(event.Current, event.Total, progressBarWidth),
+			units.HumanSize(float64(event.Current)), units.HumanSize(float64(event.Total)))
+		// bar replaces the textual progress reported by StatusText
+		status = ""
 	} else {
 		txt = fmt.Sprintf("%s %s", event.ID, event.Text)
 	}
-	textLen := len(txt)
+	textLen := lenAnsi(txt)
 	padding := statusPadding - textLen
 	if padding < 0 {
 		padding = 0
@@ -261,10 +697,9 @@ func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPaddi
 	// calculate the max length for the status text, on errors it
 	// is 2-3 lines long and breaks the line formatting
 	maxStatusLen := terminalWidth - textLen - statusPadding - 15
-	status := event.StatusText
 	// in some cases (debugging under VS Code), terminalWidth is set to zero by goterm.Width() ; ensuring we don't tweak strings with negative char index
-	if maxStatusLen > 0 && len(status) > maxStatusLen {
-		status = status[:maxStatusLen] + "..."
+	if maxStatusLen > 0 {
+		status = truncate(status, maxStatusLen)
 	}
 	text := fmt.Sprintf("%s %s%s %s%s %s",
 		pad,
@@ -274,25 +709,61 @@ func (w *ttyWriter) lineText(event Event, pad string, terminalWidth, statusPaddi
 		strings.Repeat(" ", padding),
 		event.Status.colorFn()(status),
 	)
-	timer := fmt.Sprintf("%.1fs ", elapsed)
+	timer := formatElapsed(elapsed, w.milliseconds) + " "
 	o := align(text, TimerColor(timer), terminalWidth)
 
 	return o
 }
 
-func numDone(events map[string]Event) int {
-	i := 0
+func logLineText(l logLine, terminalWidth int) string {
+	return align(fmt.Sprintf("%s | %s", l.id, l.line), "", terminalWidth)
+}
+
+const progressBarWidth = 20
+
+// progressBar renders a fixed width bar proportional to current/total
+func progressBar(current, total int64, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = int(current * int64(width) / total)
+	}
+	switch {
+	case filled <= 0:
+		return strings.Repeat(" ", width)
+	case filled >= width:
+		return strings.Repeat("=", width)
+	default:
+		return strings.Repeat("=", filled-1) + ">" + strings.Repeat(" ", width-filled)
+	}
+}
+
+// numDone counts completed top-level events within a section, children being rendered as part of their parent
+func numDone(events map[string]Event, group string) (done int, total int) {
 	for _, e := range events {
+		if e.ParentID != "" || e.Group != group {
+			continue
+		}
+		total++
 		if e.Status != Working {
-			i++
+			done++
 		}
 	}
-	return i
+	return done, total
 }
 
+// align renders l and r at both ends of a single line of width w. Content is truncated so the
+// line never wraps, as this would break the cursor moves used to redraw the progress display
 func align(l, r string, w int) string {
+	l = strings.ReplaceAll(l, "\n", " ")
 	ll := lenAnsi(l)
 	lr := lenAnsi(r)
+	if w > 0 && ll+lr > w {
+		if lr >= w {
+			r, lr = "", 0
+		}
+		l = truncate(l, w-lr)
+		ll = lenAnsi(l)
+	}
 	pad := ""
 	count := w - ll - lr
 	if count > 0 {
@@ -301,7 +772,43 @@ func align(l, r string, w int) string {
 	return fmt.Sprintf("%s%s%s\n", l, pad, r)
 }
 
-// lenAnsi count of user-perceived characters in ANSI string.
+// truncate shortens an ANSI string to width display columns, ending with an ellipsis
+func truncate(s string, width int) string {
+	if lenAnsi(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	var b strings.Builder
+	length := 0
+	ansiCode := false
+	colored := false
+	for _, r := range s {
+		if r == '\x1b' {
+			ansiCode = true
+			colored = true
+		}
+		if ansiCode {
+			b.WriteRune(r)
+			ansiCode = r != 'm'
+			continue
+		}
+		rw := runewidth.RuneWidth(r)
+		if length+rw > width-lenAnsi(ellipsis) {
+			break
+		}
+		b.WriteRune(r)
+		length += rw
+	}
+	b.WriteString(ellipsis)
+	if colored {
+		b.WriteString(aec.Reset)
+	}
+	return b.String()
+}
+
+// lenAnsi computes the display width of an ANSI string, as wide characters (CJK, emoji) use two columns.
 func lenAnsi(s string) int {
 	length := 0
 	ansiCode := false
@@ -315,7 +822,7 @@ func lenAnsi(s string) int {
 			continue
 		}
 		if !ansiCode {
-			length++
+			length += runewidth.RuneWidth(r)
 		}
 	}
 	return length
@@ -323,4 +830,6 @@ func lenAnsi(s string) int {
 
 var (
 	percentChars = strings.Split("⠀⡀⣀⣄⣤⣦⣶⣷⣿", "")
+	ellipsis     = "…"
+	termWidth    = goterm.Width
 )
@@ -17,11 +17,18 @@
 package progress
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/morikuni/aec"
 	"gotest.tools/v3/assert"
 )
 
@@ -76,6 +83,310 @@ func TestLineTextSingleEvent(t *testing.T) {
 	assert.Equal(t, out, " \x1b[32m✔\x1b[0m id Text \x1b[32mStatus\x1b[0m                            \x1b[34m0.0s \x1b[0m\n")
 }
 
+func TestLineTextMilliseconds(t *testing.T) {
+	var options writerOptions
+	WithMilliseconds()(&options)
+	assert.Assert(t, options.milliseconds)
+
+	now := time.Now()
+	ev := Event{
+		ID:         "id",
+		Text:       "Text",
+		Status:     Done,
+		StatusText: "Status",
+		startTime:  now,
+		endTime:    now.Add(42 * time.Millisecond),
+		spinner: &spinner{
+			chars: []string{"."},
+		},
+	}
+	w := tty()
+	w.milliseconds = options.milliseconds
+	out := w.lineText(ev, "", 50, 0, false)
+	assert.Assert(t, strings.HasSuffix(out, "\x1b[34m42ms \x1b[0m\n"), out)
+
+	w.milliseconds = false
+	out = w.lineText(ev, "", 50, 0, false)
+	assert.Assert(t, strings.HasSuffix(out, "\x1b[34m0.0s \x1b[0m\n"), out)
+}
+
+func TestLineTextProgressBar(t *testing.T) {
+	now := time.Now()
+	ev := Event{
+		ID:         "id",
+		Text:       "Downloading",
+		Status:     Working,
+		StatusText: "[=====>    ]       5B/10B",
+		Current:    5,
+		Total:      10,
+		startTime:  now,
+		spinner: &spinner{
+			chars: []string{"."},
+		},
+	}
+
+	out := tty().lineText(ev, "", 80, 0, false)
+	assert.Equal(t, out, " . id Downloading [=========>          ]      5B/10B                       \x1b[34m0.0s \x1b[0m\n")
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, progressBar(0, 10, 10), "          ")
+	assert.Equal(t, progressBar(3, 10, 10), "==>       ")
+	assert.Equal(t, progressBar(10, 10, 10), "==========")
+	assert.Equal(t, progressBar(20, 10, 10), "==========")
+	assert.Equal(t, progressBar(5, 0, 4), "    ")
+}
+
+func TestNumDone(t *testing.T) {
+	events := map[string]Event{
+		"parent":  {ID: "parent", Status: Done},
+		"child1":  {ID: "child1", ParentID: "parent", Status: Done},
+		"child2":  {ID: "child2", ParentID: "parent", Status: Working},
+		"working": {ID: "working", Status: Working},
+	}
+	done, total := numDone(events, "")
+	assert.Equal(t, done, 1)
+	assert.Equal(t, total, 2)
+}
+
+func TestGroups(t *testing.T) {
+	w := tty()
+	gw := GroupWriter(w, "Networks")
+	gw.Event(CreatingEvent("network"))
+	GroupWriter(w, "Containers").Events([]Event{CreatingEvent("c1"), CreatedEvent("c2")})
+	gw.Event(CreatedEvent("network"))
+	w.Event(Event{ID: "layer", ParentID: "c1", Status: Working})
+
+	assert.DeepEqual(t, w.groups(), []string{"Networks", "Containers"})
+	assert.Equal(t, w.events["network"].Group, "Networks")
+
+	done, total := numDone(w.events, "Networks")
+	assert.Equal(t, done, 1)
+	assert.Equal(t, total, 1)
+	done, total = numDone(w.events, "Containers")
+	assert.Equal(t, done, 1)
+	assert.Equal(t, total, 2)
+}
+
+func TestViewport(t *testing.T) {
+	w := tty()
+	for i := 0; i < 5; i++ {
+		w.Event(CreatedEvent(fmt.Sprintf("done%d", i)))
+	}
+	w.Event(CreatingEvent("working"))
+	w.Event(Event{ID: "layer", ParentID: "working", Status: Working})
+
+	// header + 6 events + 1 child
+	assert.Equal(t, len(w.viewport(8)), 0)
+
+	hidden := w.viewport(6)
+	assert.DeepEqual(t, hidden, map[string]bool{"done0": true, "done1": true, "done2": true})
+
+	// active events are never hidden
+	hidden = w.viewport(1)
+	assert.Equal(t, len(hidden), 5)
+	assert.Assert(t, !hidden["working"])
+
+	// unknown terminal height, as stdout is not a terminal
+	assert.Equal(t, len(w.viewport(-3)), 0)
+	assert.Equal(t, len(w.viewport(math.MinInt32-2)), 0)
+}
+
+func TestErrorDetails(t *testing.T) {
+	var out bytes.Buffer
+	w := tty()
+	w.out = &out
+	w.Event(CreatingEvent("Network foo"))
+	w.Event(ErrorDetailsEvent("Network foo", errors.New("failed to create network foo: permission denied")))
+	w.Event(ErrorEvent("Volume bar"))
+	assert.Equal(t, w.events["Network foo"].StatusText, "Error")
+
+	w.printErrorDetails()
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Assert(t, strings.Contains(lines[0], "Network foo:"))
+	assert.Equal(t, lines[1], "    failed to create network foo: permission denied")
+}
+
+func TestWrap(t *testing.T) {
+	assert.DeepEqual(t, wrap("a short message", 80), []string{"a short message"})
+	assert.DeepEqual(t, wrap("failed to create network foo", 12), []string{"failed to", "create", "network foo"})
+	assert.DeepEqual(t, wrap("abcdefghij", 4), []string{"abcd", "efgh", "ij"})
+	assert.DeepEqual(t, wrap("line 1\nline 2", 80), []string{"line 1", "line 2"})
+	assert.DeepEqual(t, wrap("unknown terminal width", -1), []string{"unknown terminal width"})
+	// wide characters use 2 columns and are never split
+	assert.DeepEqual(t, wrap("服务器容器", 4), []string{"服务", "器容", "器"})
+	assert.DeepEqual(t, wrap("服务器容器", 5), []string{"服务", "器容", "器"})
+	assert.DeepEqual(t, wrap("服务器 error", 7), []string{"服务器", "error"})
+	assert.DeepEqual(t, wrap("服务", 1), []string{"服", "务"})
+}
+
+func TestNeedsRedraw(t *testing.T) {
+	w := tty()
+	w.out = io.Discard
+	assert.Assert(t, !w.needsRedraw())
+
+	w.Event(CreatedEvent("done"))
+	assert.Assert(t, w.needsRedraw())
+	assert.Equal(t, len(w.wake), 1)
+
+	w.print()
+	assert.Assert(t, !w.needsRedraw())
+	w.Event(CreatedEvent("done"))
+	assert.Assert(t, !w.needsRedraw(), "unchanged event should not trigger a redraw")
+
+	// spinner and elapsed time need to be refreshed while working
+	w.Event(CreatingEvent("working"))
+	w.print()
+	assert.Assert(t, w.needsRedraw())
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, truncate("short", 10), "short")
+	assert.Equal(t, truncate("a long status text", 10), "a long st…")
+	assert.Equal(t, truncate("\x1b[31mred text\x1b[0m", 4), "\x1b[31mred…\x1b[0m")
+	assert.Equal(t, truncate("anything", 0), "")
+}
+
+func TestAlignNeverWraps(t *testing.T) {
+	line := align("Container with-a-very-long-name\nand a multi-line id Error", "1.2s ", 30)
+	assert.Equal(t, line, "Container with-a-very-lo…1.2s \n")
+	assert.Equal(t, lenAnsi(strings.TrimSuffix(line, "\n")), 30)
+
+	assert.Equal(t, align("text", "a timer wider than terminal", 10), "text      \n")
+}
+
+func TestWideCharacters(t *testing.T) {
+	assert.Equal(t, lenAnsi("服务"), 4)
+	assert.Equal(t, lenAnsi("\x1b[32m服务\x1b[0m"), 4)
+	assert.Equal(t, truncate("服务器容器", 6), "服务…")
+
+	line := align("服务", "1.0s", 10)
+	assert.Equal(t, line, "服务  1.0s\n")
+}
+
+func TestOutcome(t *testing.T) {
+	w := tty()
+	assert.Equal(t, w.outcome(), "")
+
+	start := time.Now()
+	w.events["a"] = Event{ID: "a", Status: Done, startTime: start, endTime: start.Add(2 * time.Second)}
+	w.events["b"] = Event{ID: "b", Status: Done, startTime: start.Add(time.Second), endTime: start.Add(43200 * time.Millisecond)}
+	w.events["layer"] = Event{ID: "layer", ParentID: "b", Status: Error}
+	w.eventIDs = []string{"a", "b", "layer"}
+	assert.Equal(t, w.outcome(), SuccessColor("✔ 2 resources done in 43.2s"))
+
+	w.events["c"] = Event{ID: "c", Status: Warning, startTime: start, endTime: start}
+	w.eventIDs = append(w.eventIDs, "c")
+	assert.Equal(t, w.outcome(), SuccessColor("✔ 3 resources done in 43.2s (1 warning)"))
+
+	w.events["d"] = Event{ID: "d", Status: Error, startTime: start, endTime: start}
+	w.eventIDs = append(w.eventIDs, "d")
+	assert.Equal(t, w.outcome(), ErrorColor("✘ 4 resources done in 43.2s (1 error, 1 warning)"))
+}
+
+func TestSkippedEvent(t *testing.T) {
+	w := tty()
+	w.Event(CreatingEvent("container"))
+	w.Event(Event{ID: "layer", ParentID: "container", Status: Working})
+	w.Event(RunningEvent("container"))
+
+	e := w.events["container"]
+	assert.Equal(t, e.Status, Skipped)
+	assert.Equal(t, e.Status.String(), "Skipped")
+	assert.Assert(t, !e.endTime.IsZero(), "skipped event must stop its timer")
+	assert.Assert(t, collapsed(e))
+	assert.Equal(t, e.Spinner(), SkippedColor(spinnerDone))
+
+	done, total := numDone(w.events, "")
+	assert.Equal(t, done, 1)
+	assert.Equal(t, total, 1)
+	assert.Assert(t, strings.Contains(w.outcome(), "(1 unchanged)"))
+
+	// skipping a task due to a failed dependency is not a success
+	w.Event(SkippedEvent("dependent", "optional dependency failed"))
+	assert.Equal(t, w.events["dependent"].Status, Warning)
+	assert.Assert(t, strings.Contains(w.outcome(), "(1 warning, 1 unchanged)"))
+}
+
+func TestCoalesceUpdates(t *testing.T) {
+	w := tty()
+	w.out = io.Discard
+	w.Event(Event{ID: "layer", Status: Working, Text: "Downloading", Current: 1, Total: 10})
+	w.Event(Event{ID: "layer", Status: Working, Text: "Downloading", Current: 2, Total: 10})
+	w.Event(Event{ID: "layer", Status: Working, Text: "Downloading", Current: 3, Total: 10})
+	assert.Equal(t, w.events["layer"].Current, int64(1), "updates must be applied on next rendering")
+
+	w.print()
+	assert.Equal(t, w.events["layer"].Current, int64(3))
+
+	// status transitions are applied immediately and supersede pending updates
+	w.Event(Event{ID: "layer", Status: Working, Text: "Downloading", Current: 5, Total: 10})
+	w.Event(Event{ID: "layer", Status: Done, Text: "Pull complete"})
+	assert.Equal(t, w.events["layer"].Status, Done)
+	w.print()
+	assert.Equal(t, w.events["layer"].Text, "Pull complete")
+}
+
+func BenchmarkEvent(b *testing.B) {
+	w := tty()
+	w.out = io.Discard
+	ids := make([]string, 100)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("service%d", i)
+		w.Event(CreatingEvent(ids[i]))
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			w.Event(Event{ID: ids[i%len(ids)], Status: Working, StatusText: "Provisioning", Current: int64(i)})
+			i++
+		}
+	})
+}
+
+func TestFormatElapsed(t *testing.T) {
+	assert.Equal(t, formatElapsed(0, false), "0.0s")
+	assert.Equal(t, formatElapsed(1234*time.Millisecond, false), "1.2s")
+	assert.Equal(t, formatElapsed(125*time.Second, false), "2m05s")
+	assert.Equal(t, formatElapsed(40*time.Minute, false), "40m00s")
+	assert.Equal(t, formatElapsed(3*time.Hour+7*time.Minute+30*time.Second, false), "3h07m")
+	assert.Equal(t, formatElapsed(-time.Second, false), "0.0s")
+
+	assert.Equal(t, formatElapsed(42*time.Millisecond, true), "42ms")
+	assert.Equal(t, formatElapsed(1500*time.Millisecond, true), "1.5s")
+}
+
+func TestRestart(t *testing.T) {
+	var out bytes.Buffer
+	w := tty()
+	w.out = &out
+
+	run := func(ids ...string) {
+		errs := make(chan error)
+		go func() {
+			errs <- w.Start(context.Background())
+		}()
+		for _, id := range ids {
+			w.Event(CreatedEvent(id))
+		}
+		w.Stop()
+		w.Stop() // must not block
+		assert.NilError(t, <-errs)
+	}
+
+	run("up")
+	assert.Equal(t, len(w.eventIDs), 0)
+	assert.Equal(t, w.numLines, 0)
+	assert.Equal(t, w.Summary()[0].ID, "up")
+
+	run("down1", "down2")
+	summary := w.Summary()
+	assert.Equal(t, len(summary), 2)
+	assert.Equal(t, summary[0].ID, "down1")
+}
+
 func TestErrorEvent(t *testing.T) {
 	w := &ttyWriter{
 		events: map[string]Event{},
@@ -134,12 +445,115 @@ func TestWarningEvent(t *testing.T) {
 	assert.Assert(t, event.endTime.After(time.Now().Add(-10*time.Second)))
 }
 
+func TestNewWarningEvent(t *testing.T) {
+	w := &ttyWriter{
+		events: map[string]Event{},
+		mtx:    &sync.Mutex{},
+	}
+	w.Event(WarningEvent("id", "port 80 already exposed"))
+	event, ok := w.events["id"]
+	assert.Assert(t, ok)
+	assert.Equal(t, event.Status, Warning)
+	assert.Assert(t, !event.endTime.IsZero())
+}
+
+func TestLogLines(t *testing.T) {
+	w := tty()
+	for i := 0; i < maxLogLines+5; i++ {
+		w.Log("service", fmt.Sprintf("line %d", i))
+	}
+	assert.Equal(t, len(w.logLines), maxLogLines)
+	assert.Equal(t, w.logLines[0].line, "line 5")
+
+	out := logLineText(w.logLines[0], 20)
+	assert.Equal(t, out, "service | line 5    \n")
+}
+
+func TestPreviousLinesAfterResize(t *testing.T) {
+	w := tty()
+	w.numLines = 3
+	w.width = 100
+	// header line is not included in numLines
+	assert.Equal(t, w.previousLines(100), 4)
+	assert.Equal(t, w.previousLines(120), 4)
+	// each line, including header, now wraps on 2 rows
+	assert.Equal(t, w.previousLines(60), 8)
+	assert.Equal(t, w.previousLines(-1), 4)
+}
+
+func TestPrintAfterResize(t *testing.T) {
+	width := 100
+	defer func(f func() int) { termWidth = f }(termWidth)
+	termWidth = func() int { return width }
+
+	var out bytes.Buffer
+	w := tty()
+	w.out = &out
+	w.Event(CreatingEvent("foo"))
+	w.Event(CreatingEvent("bar"))
+	w.print()
+	assert.Equal(t, w.numLines, 2)
+
+	out.Reset()
+	w.print()
+	// cursor moves back to the header line
+	assert.Equal(t, strings.Count(out.String(), aec.Up(1).String()), 3)
+
+	out.Reset()
+	width = 50
+	w.print()
+	// header and both lines were rendered on 100 columns, and now wrap on 2 rows
+	assert.Equal(t, strings.Count(out.String(), aec.Up(1).String()), 6)
+	assert.Assert(t, strings.Contains(out.String(), aec.EraseDisplay(aec.EraseModes.Tail).String()))
+}
+
+func TestCancelInFlightEvents(t *testing.T) {
+	var out bytes.Buffer
+	w := tty()
+	w.out = &out
+	w.Event(CreatedEvent("done"))
+	w.Event(CreatingEvent("working"))
+	w.Event(CreatingEvent("failing"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- w.Start(ctx)
+	}()
+	select {
+	case <-errs:
+		t.Fatal("Start must only return once Stop is called")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// interrupted operation still reports its failure
+	w.Event(ErrorDetailsEvent("failing", errors.New("real failure")))
+	w.Stop()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	// events are reset once rendered, but timings are kept
+	summary := w.Summary()
+	assert.Equal(t, summary[0].Status, Done)
+	assert.Equal(t, summary[1].ID, "working")
+	assert.Equal(t, summary[1].Status, Canceled)
+	assert.Assert(t, !summary[1].End.IsZero())
+	assert.Equal(t, summary[2].Status, Error)
+	assert.Assert(t, strings.Contains(out.String(), "Canceled"))
+	assert.Assert(t, strings.Contains(out.String(), "real failure"))
+
+	// Stop must not block once Start returned
+	w.Stop()
+}
+
 func tty() *ttyWriter {
 	tty := &ttyWriter{
-		eventIDs: []string{},
-		events:   map[string]Event{},
-		done:     make(chan bool),
-		mtx:      &sync.Mutex{},
+		eventIDs:        []string{},
+		events:          map[string]Event{},
+		done:            make(chan bool, 1),
+		mtx:             &sync.Mutex{},
+		refreshInterval: defaultRefreshInterval,
+		wake:            make(chan struct{}, 1),
 	}
 	return tty
 }
@@ -18,12 +18,15 @@ package progress
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/containerd/console"
 	"github.com/moby/term"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/docker/compose/v2/pkg/api"
@@ -38,6 +41,17 @@ type Writer interface {
 	TailMsgf(string, ...interface{})
 }
 
+// Logger is implemented by Writers which can render log lines produced by the resource tracked
+// by event ID alongside its progress
+type Logger interface {
+	Log(id string, line string)
+}
+
+// Summarizer is implemented by Writers which record time spent on each resource
+type Summarizer interface {
+	Summary() Summary
+}
+
 type writerKey struct{}
 
 // WithContextWriter adds the writer to the context
@@ -54,6 +68,11 @@ func ContextWriter(ctx context.Context) Writer {
 	return s
 }
 
+// canceler is implemented by writers which render in-flight events once the operation is interrupted
+type canceler interface {
+	Cancel()
+}
+
 type progressFunc func(context.Context) error
 
 type progressFuncWithStatus func(context.Context) (string, error)
@@ -76,14 +95,22 @@ func RunWithTitle(ctx context.Context, pf progressFunc, out io.Writer, progressT
 // RunWithStatus will run a writer and the progress function in parallel and return a status
 func RunWithStatus(ctx context.Context, pf progressFuncWithStatus, out io.Writer, progressTitle string) (string, error) {
 	eg, _ := errgroup.WithContext(ctx)
-	w, err := NewWriter(ctx, out, progressTitle)
+	w, err := NewWriter(ctx, out, progressTitle, DefaultWriterOptions...)
 	var result string
 	if err != nil {
 		return "", err
 	}
+	// writer runs until pf completes, so that errors reported by an interrupted operation are
+	// still rendered. Interruption is only used to render in-flight events as canceled
 	eg.Go(func() error {
 		return w.Start(context.Background())
 	})
+	stopCancel := context.AfterFunc(ctx, func() {
+		if c, ok := w.(canceler); ok {
+			c.Cancel()
+		}
+	})
+	defer stopCancel()
 
 	ctx = WithContextWriter(ctx, w)
 
@@ -97,6 +124,9 @@ func RunWithStatus(ctx context.Context, pf progressFuncWithStatus, out io.Writer
 	})
 
 	err = eg.Wait()
+	if s, ok := w.(Summarizer); ok {
+		collectSummary(ctx, s.Summary())
+	}
 	return result, err
 }
 
@@ -105,57 +135,175 @@ const (
 	ModeAuto = "auto"
 	// ModeTTY use terminal capability for advanced rendering
 	ModeTTY = "tty"
+	// ModeTTYASCII is ModeTTY restricted to ASCII characters, see ASCII
+	ModeTTYASCII = "tty-ascii"
 	// ModePlain dump raw events to output
 	ModePlain = "plain"
 	// ModeQuiet don't display events
 	ModeQuiet = "quiet"
+	// ModeJSON outputs a machine-readable JSON stream
+	ModeJSON = "json"
 )
 
 // Mode define how progress should be rendered, either as ModePlain or ModeTTY
 var Mode = ModeAuto
 
+const defaultRefreshInterval = 100 * time.Millisecond
+
+// WriterOption customizes rendering of a progress Writer
+type WriterOption func(*writerOptions)
+
+type writerOptions struct {
+	spinnerStyle    SpinnerStyle
+	refreshInterval time.Duration
+	eventLog        io.Writer
+	tracer          trace.Tracer
+	milliseconds    bool
+}
+
+// WithSpinner sets the glyphs used to render spinners
+func WithSpinner(style SpinnerStyle) WriterOption {
+	return func(o *writerOptions) {
+		o.spinnerStyle = style
+	}
+}
+
+// WithRefreshInterval sets the delay between two renderings of the progress display
+func WithRefreshInterval(interval time.Duration) WriterOption {
+	return func(o *writerOptions) {
+		if interval > 0 {
+			o.refreshInterval = interval
+		}
+	}
+}
+
+// ParseRefreshInterval parses a refresh interval as a duration, which must be positive
+func ParseRefreshInterval(s string) (time.Duration, error) {
+	interval, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid refresh interval %q: %w", s, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("refresh interval must be positive (found: %q)", s)
+	}
+	return interval, nil
+}
+
+// WithMilliseconds renders elapsed time under a second in milliseconds, for fast local operations
+func WithMilliseconds() WriterOption {
+	return func(o *writerOptions) {
+		o.milliseconds = true
+	}
+}
+
+// WithEventLog appends a JSON copy of all progress events to out
+func WithEventLog(out io.Writer) WriterOption {
+	return func(o *writerOptions) {
+		o.eventLog = out
+	}
+}
+
+// WithTracing opens an OpenTelemetry span for each resource while it is in progress
+func WithTracing(tracer trace.Tracer) WriterOption {
+	return func(o *writerOptions) {
+		o.tracer = tracer
+	}
+}
+
+// DefaultWriterOptions are applied to writers created by Run functions
+var DefaultWriterOptions []WriterOption
+
 // NewWriter returns a new multi-progress writer
-func NewWriter(ctx context.Context, out io.Writer, progressTitle string) (Writer, error) {
-	_, isTerminal := term.GetFdInfo(out)
+func NewWriter(ctx context.Context, out io.Writer, progressTitle string, opts ...WriterOption) (Writer, error) {
+	options := writerOptions{
+		refreshInterval: defaultRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
 	dryRun, ok := ctx.Value(api.DryRunKey{}).(bool)
 	if !ok {
 		dryRun = false
 	}
+	w, err := newWriter(out, dryRun, progressTitle, options)
+	if err != nil {
+		return nil, err
+	}
+	if options.eventLog != nil {
+		w = &teeWriter{
+			Writer:    w,
+			secondary: newJSONWriter(options.eventLog, dryRun),
+		}
+	}
+	if options.tracer != nil {
+		w = newTracingWriter(ctx, w, options.tracer)
+	}
+	return w, nil
+}
+
+func newWriter(out io.Writer, dryRun bool, progressTitle string, options writerOptions) (Writer, error) {
+	_, isTerminal := term.GetFdInfo(out)
 	if Mode == ModeQuiet {
-		return quiet{}, nil
+		return quiet{out: out}, nil
+	}
+	if Mode == ModeJSON {
+		return newJSONWriter(out, dryRun), nil
+	}
+	if factory, ok := registeredWriter(Mode); ok {
+		return factory(out, dryRun, progressTitle)
+	}
+	if Mode == ModeAuto && isGitHubActions() {
+		return newGitHubWriter(out, dryRun), nil
 	}
 	f, isConsole := out.(console.File) // see https://github.com/docker/compose/issues/10560
 	if Mode == ModeAuto && isTerminal && isConsole {
-		return newTTYWriter(f, dryRun, progressTitle)
+		return newTTYWriter(f, dryRun, progressTitle, options)
 	}
 	if Mode == ModeTTY {
 		if !isConsole {
 			logrus.Warn("Terminal is not a POSIX console")
 		} else {
-			return newTTYWriter(f, dryRun, progressTitle)
+			return newTTYWriter(f, dryRun, progressTitle, options)
 		}
 	}
 	return &plainWriter{
-		out:    out,
-		done:   make(chan bool),
-		dryRun: dryRun,
+		out:     out,
+		done:    make(chan bool, 1),
+		dryRun:  dryRun,
+		mtx:     &sync.Mutex{},
+		last:    map[string]Event{},
+		timings: newTimings(),
 	}, nil
 }
 
-func newTTYWriter(out console.File, dryRun bool, progressTitle string) (Writer, error) {
+func newJSONWriter(out io.Writer, dryRun bool) *jsonWriter {
+	return &jsonWriter{
+		out:     out,
+		done:    make(chan bool, 1),
+		dryRun:  dryRun,
+		mtx:     &sync.Mutex{},
+		timings: newTimings(),
+	}
+}
+
+func newTTYWriter(out console.File, dryRun bool, progressTitle string, options writerOptions) (Writer, error) {
 	con, err := console.ConsoleFromFile(out)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ttyWriter{
-		out:           con,
-		eventIDs:      []string{},
-		events:        map[string]Event{},
-		repeated:      false,
-		done:          make(chan bool),
-		mtx:           &sync.Mutex{},
-		dryRun:        dryRun,
-		progressTitle: progressTitle,
+		out:             con,
+		eventIDs:        []string{},
+		events:          map[string]Event{},
+		repeated:        false,
+		done:            make(chan bool, 1),
+		mtx:             &sync.Mutex{},
+		dryRun:          dryRun,
+		progressTitle:   progressTitle,
+		spinnerStyle:    options.spinnerStyle,
+		refreshInterval: options.refreshInterval,
+		milliseconds:    options.milliseconds,
+		wake:            make(chan struct{}, 1),
 	}, nil
 }
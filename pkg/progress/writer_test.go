@@ -17,8 +17,14 @@
 package progress
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 )
@@ -29,3 +35,86 @@ func TestNoopWriter(t *testing.T) {
 
 	assert.Equal(t, writer, &noopWriter{})
 }
+
+func TestEventLog(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "") // force plain writer
+	var out, log bytes.Buffer
+	w, err := NewWriter(context.TODO(), &out, "Running", WithEventLog(&log))
+	assert.NilError(t, err)
+
+	w.Event(CreatingEvent("service"))
+	w.TailMsgf("done")
+
+	assert.Equal(t, out.String(), " service  Creating\ndone\n")
+	// timestamps are checked by TestJsonWriter
+	stripped := regexp.MustCompile(`"timestamp":"[^"]+",`).ReplaceAllString(log.String(), "")
+	lines := strings.Split(strings.TrimSpace(stripped), "\n")
+	assert.DeepEqual(t, lines, []string{
+		`{"id":"service","status":"Working","status_text":"Creating"}`,
+		`{"tail":true,"text":"done"}`,
+	})
+}
+
+func TestRunWithStatusInterrupted(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "") // force plain writer
+	var out bytes.Buffer
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := RunWithStatus(ctx, func(ctx context.Context) (string, error) {
+		w := ContextWriter(ctx)
+		w.Event(CreatingEvent("c1"))
+		cancel()
+		<-ctx.Done()
+		w.Event(ErrorMessageEvent("c1", "real failure"))
+		return "", errors.New("real failure")
+	}, &out, "Running")
+	assert.Error(t, err, "real failure")
+	assert.Assert(t, strings.Contains(out.String(), "c1  real failure"))
+}
+
+func TestLoggerThroughGroupWriter(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "") // force plain writer
+	var out bytes.Buffer
+	w, err := NewWriter(context.TODO(), &out, "Running")
+	assert.NilError(t, err)
+
+	l, ok := GroupWriter(w, "Containers").(Logger)
+	assert.Assert(t, ok)
+	l.Log("service", "starting")
+	assert.Equal(t, out.String(), "service | starting\n")
+
+	// Writers implemented outside this package don't have to support optional interfaces
+	minimal := struct{ Writer }{&noopWriter{}}
+	GroupWriter(minimal, "Containers").(Logger).Log("service", "ignored")
+	assert.Assert(t, GroupWriter(minimal, "Containers").(Summarizer).Summary() == nil)
+}
+
+func TestSummaryAcrossNestedRuns(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "") // force plain writer
+	ctx, summary := WithSummary(context.Background())
+	err := Run(ctx, func(ctx context.Context) error {
+		ContextWriter(ctx).Event(CreatedEvent("container"))
+		return Run(ctx, func(ctx context.Context) error {
+			ContextWriter(ctx).Event(CreatedEvent("image"))
+			return nil
+		}, io.Discard)
+	}, io.Discard)
+	assert.NilError(t, err)
+
+	s := summary()
+	assert.Equal(t, len(s), 2)
+	assert.Equal(t, s[0].ID, "image")
+	assert.Equal(t, s[1].ID, "container")
+}
+
+func TestParseRefreshInterval(t *testing.T) {
+	interval, err := ParseRefreshInterval("250ms")
+	assert.NilError(t, err)
+	assert.Equal(t, interval, 250*time.Millisecond)
+
+	_, err = ParseRefreshInterval("fast")
+	assert.ErrorContains(t, err, `invalid refresh interval "fast"`)
+	_, err = ParseRefreshInterval("0s")
+	assert.ErrorContains(t, err, "must be positive")
+	_, err = ParseRefreshInterval("-1s")
+	assert.ErrorContains(t, err, "must be positive")
+}